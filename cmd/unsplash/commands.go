@@ -0,0 +1,469 @@
+// Copyright (c) 2017 Hardik Bagdi <hbagdi1@binghamton.edu>
+//
+// MIT License
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/hbagdi/go-unsplash/unsplash"
+)
+
+func (a *app) search(args []string) error {
+	return a.subcommand("search", args, map[string]func([]string) error{
+		"photos":      a.searchPhotos,
+		"users":       a.searchUsers,
+		"collections": a.searchCollections,
+	})
+}
+
+// searchFlags defines the paging flags shared by the search commands.
+func (a *app) searchFlags(name string) (*flag.FlagSet, *unsplash.SearchOpt) {
+	fs := a.flags(name)
+	opt := new(unsplash.SearchOpt)
+	fs.IntVar(&opt.Page, "page", 1, "page to show")
+	fs.IntVar(&opt.PerPage, "per-page", 10, "results per page, at most 30")
+	return fs, opt
+}
+
+func (a *app) searchPhotos(args []string) error {
+	fs, searchOpt := a.searchFlags("search photos")
+	orientation := fs.String("orientation", "", "landscape, portrait or squarish")
+	orderBy := fs.String("order-by", "", "relevant or latest")
+	args, err := a.parse(fs, args, "[flags] <query>", 1, -1)
+	if err != nil {
+		return err
+	}
+	searchOpt.Query = strings.Join(args, " ")
+	opt := &unsplash.PhotoSearchOpt{SearchOpt: *searchOpt, OrderBy: *orderBy}
+	switch *orientation {
+	case "":
+	case "landscape":
+		opt.Orientation = unsplash.Landscape
+	case "portrait":
+		opt.Orientation = unsplash.Portrait
+	case "squarish":
+		opt.Orientation = unsplash.Squarish
+	default:
+		return fmt.Errorf("unknown orientation %q", *orientation)
+	}
+	client, err := a.unsplash()
+	if err != nil {
+		return err
+	}
+	result, _, err := client.Search.FilterPhotosWithContext(a.ctx, opt)
+	if err != nil {
+		return err
+	}
+	var photos []unsplash.Photo
+	if result.Results != nil {
+		photos = *result.Results
+	}
+	return a.print(result, photosTable(photos))
+}
+
+func (a *app) searchUsers(args []string) error {
+	fs, opt := a.searchFlags("search users")
+	args, err := a.parse(fs, args, "[flags] <query>", 1, -1)
+	if err != nil {
+		return err
+	}
+	opt.Query = strings.Join(args, " ")
+	client, err := a.unsplash()
+	if err != nil {
+		return err
+	}
+	result, _, err := client.Search.UsersWithContext(a.ctx, opt)
+	if err != nil {
+		return err
+	}
+	var users []unsplash.User
+	if result.Results != nil {
+		users = *result.Results
+	}
+	return a.print(result, usersTable(users))
+}
+
+func (a *app) searchCollections(args []string) error {
+	fs, opt := a.searchFlags("search collections")
+	args, err := a.parse(fs, args, "[flags] <query>", 1, -1)
+	if err != nil {
+		return err
+	}
+	opt.Query = strings.Join(args, " ")
+	client, err := a.unsplash()
+	if err != nil {
+		return err
+	}
+	result, _, err := client.Search.CollectionsWithContext(a.ctx, opt)
+	if err != nil {
+		return err
+	}
+	var collections []unsplash.Collection
+	if result.Results != nil {
+		collections = *result.Results
+	}
+	return a.print(result, collectionsTable(collections))
+}
+
+func (a *app) photo(args []string) error {
+	fs := a.flags("photo")
+	args, err := a.parse(fs, args, "<id>", 1, 1)
+	if err != nil {
+		return err
+	}
+	client, err := a.unsplash()
+	if err != nil {
+		return err
+	}
+	photo, _, err := client.Photos.PhotoWithContext(a.ctx, args[0], nil)
+	if err != nil {
+		return err
+	}
+	return a.print(photo, photoTable(photo))
+}
+
+func (a *app) random(args []string) error {
+	fs := a.flags("random")
+	opt := new(unsplash.RandomPhotoOpt)
+	fs.IntVar(&opt.Count, "count", 1, "number of photos, at most 30")
+	fs.StringVar(&opt.SearchQuery, "query", "", "limit to photos matching the query")
+	fs.StringVar(&opt.Username, "username", "", "limit to photos of the user")
+	fs.BoolVar(&opt.Featured, "featured", false, "limit to featured photos")
+	orientation := fs.String("orientation", "", "landscape, portrait or squarish")
+	if _, err := a.parse(fs, args, "[flags]", 0, 0); err != nil {
+		return err
+	}
+	switch *orientation {
+	case "":
+	case "landscape":
+		opt.Orientation = unsplash.Landscape
+	case "portrait":
+		opt.Orientation = unsplash.Portrait
+	case "squarish":
+		opt.Orientation = unsplash.Squarish
+	default:
+		return fmt.Errorf("unknown orientation %q", *orientation)
+	}
+	client, err := a.unsplash()
+	if err != nil {
+		return err
+	}
+	photos, _, err := client.Photos.RandomWithContext(a.ctx, opt)
+	if err != nil {
+		return err
+	}
+	return a.print(photos, photosTable(*photos))
+}
+
+func (a *app) download(args []string) error {
+	fs := a.flags("download")
+	opt := new(unsplash.DownloadOpt)
+	size := fs.String("size", "full", "raw, full, regular, small or thumb")
+	width := fs.Int("width", 0, "resize the raw photos to this width instead")
+	fs.StringVar(&opt.Dir, "dir", "", "directory to write the photos to")
+	fs.IntVar(&opt.Workers, "workers", 4, "number of concurrent downloads")
+	fs.BoolVar(&opt.Overwrite, "overwrite", false, "download photos again if their file exists")
+	fs.BoolVar(&opt.WriteChecksums, "checksums", false, "write a .sha256 file next to every photo")
+	output := fs.String("o", "", "output file for a single photo, - for stdout")
+	ids, err := a.parse(fs, args, "[flags] <id>...", 1, -1)
+	if err != nil {
+		return err
+	}
+	if *output != "" && (len(ids) != 1 || opt.Dir != "" || opt.WriteChecksums) {
+		fmt.Fprintln(a.stderr, "-o takes a single photo and can't be combined with -dir or -checksums")
+		fs.Usage()
+		return errUsage
+	}
+	opt.Size = unsplash.DownloadSize(*size)
+	if *width > 0 {
+		opt.Image = &unsplash.ImageOpt{Width: *width}
+	}
+	client, err := a.unsplash()
+	if err != nil {
+		return err
+	}
+	if *output != "" {
+		return a.downloadTo(client, opt, ids[0], *output)
+	}
+	downloader, err := unsplash.NewDownloader(client, opt)
+	if err != nil {
+		return err
+	}
+	results, downloadErr := downloader.DownloadIDsWithContext(a.ctx, ids)
+	t := table{header: []string{"id", "path", "size", "sha256", "status"}}
+	for _, result := range results {
+		status := "downloaded"
+		switch {
+		case result.Err != nil:
+			status = result.Err.Error()
+		case result.Skipped:
+			status = "exists"
+		case result.Resumed:
+			status = "resumed"
+		}
+		t.rows = append(t.rows, []string{result.PhotoID, result.Path,
+			strconv.FormatInt(result.Size, 10), result.SHA256, status})
+	}
+	if err = a.print(results, t); err != nil {
+		return err
+	}
+	return downloadErr
+}
+
+// downloadTo downloads the photo id to path, or to stdout if path is "-".
+// Like other downloads, an existing path is only replaced with -overwrite.
+// The photo is downloaded to a temporary directory first, so path is never
+// left partially written.
+func (a *app) downloadTo(client *unsplash.Unsplash, opt *unsplash.DownloadOpt, id, path string) error {
+	dir := ""
+	if path != "-" {
+		if _, err := os.Stat(path); err == nil && !opt.Overwrite {
+			return fmt.Errorf("%v exists, use -overwrite to replace it", path)
+		}
+		dir = filepath.Dir(path)
+	}
+	tmp, err := ioutil.TempDir(dir, ".unsplash-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmp)
+	opt.Dir = tmp
+	downloader, err := unsplash.NewDownloader(client, opt)
+	if err != nil {
+		return err
+	}
+	results, err := downloader.DownloadIDsWithContext(a.ctx, []string{id})
+	if err != nil {
+		return err
+	}
+	if path != "-" {
+		return os.Rename(results[0].Path, path)
+	}
+	f, err := os.Open(results[0].Path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = io.Copy(a.stdout, f)
+	return err
+}
+
+func (a *app) collection(args []string) error {
+	return a.subcommand("collection", args, map[string]func([]string) error{
+		"list":   a.collectionList,
+		"create": a.collectionCreate,
+		"add":    a.collectionAdd,
+		"remove": a.collectionRemove,
+	})
+}
+
+func (a *app) collectionList(args []string) error {
+	fs := a.flags("collection list")
+	opt := new(unsplash.ListOpt)
+	fs.IntVar(&opt.Page, "page", 1, "page to show")
+	fs.IntVar(&opt.PerPage, "per-page", 10, "collections per page, at most 30")
+	user := fs.String("user", "", "list the collections of this user")
+	featured := fs.Bool("featured", false, "list featured collections")
+	if _, err := a.parse(fs, args, "[flags]", 0, 0); err != nil {
+		return err
+	}
+	client, err := a.unsplash()
+	if err != nil {
+		return err
+	}
+	var collections *[]unsplash.Collection
+	switch {
+	case *user != "":
+		collections, _, err = client.Users.CollectionsWithContext(a.ctx, *user, opt)
+	case *featured:
+		collections, _, err = client.Collections.FeaturedWithContext(a.ctx, opt)
+	default:
+		collections, _, err = client.Collections.AllWithContext(a.ctx, opt)
+	}
+	if err != nil {
+		return err
+	}
+	return a.print(collections, collectionsTable(*collections))
+}
+
+func (a *app) collectionCreate(args []string) error {
+	fs := a.flags("collection create")
+	description := fs.String("description", "", "description of the collection")
+	private := fs.Bool("private", false, "make the collection private")
+	args, err := a.parse(fs, args, "[flags] <title>", 1, -1)
+	if err != nil {
+		return err
+	}
+	title := strings.Join(args, " ")
+	opt := &unsplash.CollectionOpt{Title: &title, Private: private}
+	if *description != "" {
+		opt.Description = description
+	}
+	client, err := a.unsplash()
+	if err != nil {
+		return err
+	}
+	collection, _, err := client.Collections.CreateWithContext(a.ctx, opt)
+	if err != nil {
+		return err
+	}
+	return a.print(collection, collectionTable(collection))
+}
+
+func (a *app) collectionAdd(args []string) error {
+	return a.collectionPhoto("add", args)
+}
+
+func (a *app) collectionRemove(args []string) error {
+	return a.collectionPhoto("remove", args)
+}
+
+// collectionPhoto adds a photo to or removes it from a collection.
+func (a *app) collectionPhoto(action string, args []string) error {
+	fs := a.flags("collection " + action)
+	args, err := a.parse(fs, args, "<collection-id> <photo-id>", 2, 2)
+	if err != nil {
+		return err
+	}
+	id, err := strconv.Atoi(args[0])
+	if err != nil {
+		return fmt.Errorf("invalid collection ID %q", args[0])
+	}
+	client, err := a.unsplash()
+	if err != nil {
+		return err
+	}
+	if action == "add" {
+		_, err = client.Collections.AddPhotoWithContext(a.ctx, id, args[1])
+	} else {
+		_, err = client.Collections.RemovePhotoWithContext(a.ctx, id, args[1])
+	}
+	if err != nil {
+		return err
+	}
+	collection, _, err := client.Collections.CollectionWithContext(a.ctx, args[0])
+	if err != nil {
+		return err
+	}
+	return a.print(collection, collectionTable(collection))
+}
+
+func (a *app) user(args []string) error {
+	return a.subcommand("user", args, map[string]func([]string) error{
+		"info":  a.userInfo,
+		"stats": a.userStats,
+	})
+}
+
+func (a *app) userInfo(args []string) error {
+	fs := a.flags("user info")
+	args, err := a.parse(fs, args, "<username>", 1, 1)
+	if err != nil {
+		return err
+	}
+	client, err := a.unsplash()
+	if err != nil {
+		return err
+	}
+	user, err := client.Users.UserWithContext(a.ctx, args[0], nil)
+	if err != nil {
+		return err
+	}
+	return a.print(user, userTable(user))
+}
+
+func (a *app) userStats(args []string) error {
+	fs := a.flags("user stats")
+	opt := new(unsplash.StatsOpt)
+	fs.StringVar(&opt.Resolution, "resolution", "days", "resolution of the historical values")
+	fs.IntVar(&opt.Quantity, "quantity", 30, "number of days, between 1 and 30")
+	args, err := a.parse(fs, args, "[flags] <username>", 1, 1)
+	if err != nil {
+		return err
+	}
+	client, err := a.unsplash()
+	if err != nil {
+		return err
+	}
+	stats, _, err := client.Users.StatisticsWithContext(a.ctx, args[0], opt)
+	if err != nil {
+		return err
+	}
+	return a.print(stats, fields(
+		"username", stats.Username,
+		"downloads", strconv.Itoa(stats.Downloads.Total),
+		"views", strconv.Itoa(stats.Views.Total),
+		"likes", strconv.Itoa(stats.Likes.Total),
+	))
+}
+
+func (a *app) stats(args []string) error {
+	fs := a.flags("stats")
+	month := fs.Bool("month", false, "show the statistics of the last 30 days")
+	if _, err := a.parse(fs, args, "[flags]", 0, 0); err != nil {
+		return err
+	}
+	client, err := a.unsplash()
+	if err != nil {
+		return err
+	}
+	u := func(n uint64) string { return strconv.FormatUint(n, 10) }
+	if *month {
+		stats, _, err := client.MonthStatsWithContext(a.ctx)
+		if err != nil {
+			return err
+		}
+		return a.print(stats, fields(
+			"downloads", u(stats.Downloads),
+			"views", u(stats.Views),
+			"likes", u(stats.Likes),
+			"new_photos", u(stats.NewPhotos),
+			"new_photographers", u(stats.NewPhotographers),
+			"new_developers", u(stats.NewDevelopers),
+			"new_applications", u(stats.NewApplications),
+			"new_requests", u(stats.NewRequests),
+		))
+	}
+	stats, _, err := client.TotalStatsWithContext(a.ctx)
+	if err != nil {
+		return err
+	}
+	return a.print(stats, fields(
+		"photos", u(stats.Photos),
+		"downloads", u(stats.Downloads),
+		"views", u(stats.Views),
+		"likes", u(stats.Likes),
+		"photographers", u(stats.Photographers),
+		"developers", u(stats.Developers),
+		"applications", u(stats.Applications),
+		"requests", u(stats.Requests),
+	))
+}
@@ -0,0 +1,120 @@
+// Copyright (c) 2017 Hardik Bagdi <hbagdi1@binghamton.edu>
+//
+// MIT License
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/hbagdi/go-unsplash/unsplash"
+	"golang.org/x/oauth2"
+)
+
+// config holds the credentials of the command.
+type config struct {
+	// AccessKey is the access key of the application, used as Client-ID.
+	AccessKey string `json:"access_key"`
+	// Token is an OAuth access token, needed to act on behalf of a user.
+	Token string `json:"token"`
+	// BaseURL overrides the API URL.
+	BaseURL string `json:"base_url"`
+}
+
+// loadConfig reads the config file, if any, and applies the environment
+// on top of it.
+func (a *app) loadConfig() (*config, error) {
+	path, explicit := a.configPath, true
+	if path == "" {
+		path = a.getenv("UNSPLASH_CONFIG")
+	}
+	if path == "" {
+		path, explicit = a.defaultConfigPath(), false
+	}
+	cfg := new(config)
+	if path != "" {
+		data, err := ioutil.ReadFile(path)
+		switch {
+		case err == nil:
+			if err = json.Unmarshal(data, cfg); err != nil {
+				return nil, fmt.Errorf("invalid config file %v: %v", path, err)
+			}
+		case explicit || !os.IsNotExist(err):
+			return nil, err
+		}
+	}
+	for env, field := range map[string]*string{
+		"UNSPLASH_ACCESS_KEY": &cfg.AccessKey,
+		"UNSPLASH_TOKEN":      &cfg.Token,
+		"UNSPLASH_BASE_URL":   &cfg.BaseURL,
+	} {
+		if v := a.getenv(env); v != "" {
+			*field = v
+		}
+	}
+	return cfg, nil
+}
+
+// defaultConfigPath returns $XDG_CONFIG_HOME/unsplash/config.json,
+// falling back to ~/.config when XDG_CONFIG_HOME isn't set.
+func (a *app) defaultConfigPath() string {
+	if dir := a.getenv("XDG_CONFIG_HOME"); dir != "" {
+		return filepath.Join(dir, "unsplash", "config.json")
+	}
+	if home := a.getenv("HOME"); home != "" {
+		return filepath.Join(home, ".config", "unsplash", "config.json")
+	}
+	if dir, err := os.UserConfigDir(); err == nil {
+		return filepath.Join(dir, "unsplash", "config.json")
+	}
+	return ""
+}
+
+// unsplash returns the API client, creating it on first use.
+func (a *app) unsplash() (*unsplash.Unsplash, error) {
+	if a.client != nil {
+		return a.client, nil
+	}
+	cfg, err := a.loadConfig()
+	if err != nil {
+		return nil, err
+	}
+	opts := []unsplash.Option{unsplash.WithUserAgent("go-unsplash-cli")}
+	if cfg.BaseURL != "" {
+		opts = append(opts, unsplash.WithBaseURL(cfg.BaseURL))
+	}
+	switch {
+	case cfg.Token != "":
+		ts := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: cfg.Token})
+		a.client = unsplash.New(oauth2.NewClient(a.ctx, ts), opts...)
+	case cfg.AccessKey != "":
+		a.client = unsplash.NewWithClientID(nil, cfg.AccessKey, opts...)
+	default:
+		return nil, errors.New("no credentials, set UNSPLASH_ACCESS_KEY or UNSPLASH_TOKEN or add them to the config file")
+	}
+	return a.client, nil
+}
@@ -0,0 +1,205 @@
+// Copyright (c) 2017 Hardik Bagdi <hbagdi1@binghamton.edu>
+//
+// MIT License
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+// Command unsplash is a command-line client for the Unsplash API.
+//
+// Usage:
+//
+//	unsplash [-format table|json|csv] [-config file] <command> [flags] [args]
+//
+// Commands:
+//
+//	search photos|users|collections <query>
+//	photo <id>
+//	random
+//	download <id>
+//	collection list|create|add|remove
+//	user info|stats <username>
+//	stats
+//
+// Credentials are read from the UNSPLASH_ACCESS_KEY and UNSPLASH_TOKEN
+// environment variables, or from a JSON config file:
+//
+//	{"access_key": "...", "token": "..."}
+//
+// The config file defaults to $XDG_CONFIG_HOME/unsplash/config.json, or
+// ~/.config/unsplash/config.json, and can be set with -config or
+// UNSPLASH_CONFIG.
+// Environment variables take precedence over the file.
+// Commands acting on behalf of a user, like collection create, need a token.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/hbagdi/go-unsplash/unsplash"
+)
+
+// errUsage is returned by commands called with the wrong arguments,
+// after the usage was printed.
+var errUsage = errors.New("usage")
+
+const usage = `Usage: unsplash [-format table|json|csv] [-config file] <command> [flags] [args]
+
+Commands:
+  search photos|users|collections <query>   search the API
+  photo <id>                                show a photo
+  random                                    show random photos
+  download <id>                             download a photo
+  collection list                           list collections
+  collection create <title>                 create a collection
+  collection add <collection> <photo>       add a photo to a collection
+  collection remove <collection> <photo>    remove a photo from a collection
+  user info <username>                      show a user
+  user stats <username>                     show statistics of a user
+  stats                                     show global statistics
+
+Run 'unsplash <command> -h' for the flags of a command.
+Credentials are read from UNSPLASH_ACCESS_KEY and UNSPLASH_TOKEN or the config file.
+`
+
+// app holds the state shared by the commands.
+type app struct {
+	ctx    context.Context
+	getenv func(string) string
+	stdout io.Writer
+	stderr io.Writer
+
+	format     string
+	configPath string
+	client     *unsplash.Unsplash
+}
+
+func main() {
+	a := &app{
+		ctx:    context.Background(),
+		getenv: os.Getenv,
+		stdout: os.Stdout,
+		stderr: os.Stderr,
+	}
+	os.Exit(a.run(os.Args[1:]))
+}
+
+// run runs the command in args and returns the exit code.
+func (a *app) run(args []string) int {
+	fs := a.flags("unsplash")
+	fs.Usage = func() { fmt.Fprint(a.stderr, usage) }
+	if err := fs.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return 0
+		}
+		return 2
+	}
+	args = fs.Args()
+	if len(args) == 0 {
+		fmt.Fprint(a.stderr, usage)
+		return 2
+	}
+	commands := map[string]func([]string) error{
+		"search":     a.search,
+		"photo":      a.photo,
+		"random":     a.random,
+		"download":   a.download,
+		"collection": a.collection,
+		"user":       a.user,
+		"stats":      a.stats,
+	}
+	command, ok := commands[args[0]]
+	if !ok {
+		fmt.Fprintf(a.stderr, "unsplash: unknown command %q\n\n%v", args[0], usage)
+		return 2
+	}
+	err := command(args[1:])
+	switch {
+	case err == nil:
+		return 0
+	case err == flag.ErrHelp:
+		return 0
+	case err == errUsage:
+		return 2
+	default:
+		fmt.Fprintf(a.stderr, "unsplash: %v\n", err)
+		return 1
+	}
+}
+
+// flags returns a FlagSet for a command, with the global flags defined,
+// so they can be given after the command too.
+func (a *app) flags(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(a.stderr)
+	if a.format == "" {
+		a.format = formatTable
+	}
+	fs.StringVar(&a.format, "format", a.format, "output format: table, json or csv")
+	fs.StringVar(&a.configPath, "config", a.configPath, "config file")
+	return fs
+}
+
+// parse parses the flags of a command which takes between min and max
+// arguments, max < 0 meaning no limit.
+func (a *app) parse(fs *flag.FlagSet, args []string, usage string, min, max int) ([]string, error) {
+	fs.Usage = func() {
+		fmt.Fprintf(a.stderr, "Usage: unsplash %v %v\n", fs.Name(), usage)
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return nil, err
+		}
+		return nil, errUsage
+	}
+	if n := fs.NArg(); n < min || (max >= 0 && n > max) {
+		fs.Usage()
+		return nil, errUsage
+	}
+	switch a.format {
+	case formatTable, formatJSON, formatCSV:
+	default:
+		return nil, fmt.Errorf("unknown format %q", a.format)
+	}
+	return fs.Args(), nil
+}
+
+// subcommand dispatches args to one of commands.
+func (a *app) subcommand(name string, args []string, commands map[string]func([]string) error) error {
+	if len(args) != 0 {
+		if command, ok := commands[args[0]]; ok {
+			return command(args[1:])
+		}
+	}
+	var names []string
+	for _, n := range []string{"photos", "users", "collections", "list", "create", "add", "remove", "info", "stats"} {
+		if _, ok := commands[n]; ok {
+			names = append(names, n)
+		}
+	}
+	fmt.Fprintf(a.stderr, "Usage: unsplash %v %v\n", name, strings.Join(names, "|"))
+	return errUsage
+}
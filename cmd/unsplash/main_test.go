@@ -0,0 +1,202 @@
+// Copyright (c) 2017 Hardik Bagdi <hbagdi1@binghamton.edu>
+//
+// MIT License
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hbagdi/go-unsplash/unsplash/unsplashtest"
+	"github.com/stretchr/testify/assert"
+)
+
+// runCLI runs the command with env and returns its exit code and output.
+func runCLI(env map[string]string, args ...string) (int, string, string) {
+	var stdout, stderr bytes.Buffer
+	a := &app{
+		ctx:    context.Background(),
+		getenv: func(key string) string { return env[key] },
+		stdout: &stdout,
+		stderr: &stderr,
+	}
+	code := a.run(args)
+	return code, stdout.String(), stderr.String()
+}
+
+func TestCLI(T *testing.T) {
+	assert := assert.New(T)
+	server := unsplashtest.NewServer()
+	defer server.Close()
+	dir, err := ioutil.TempDir("", "unsplash-cli")
+	assert.Nil(err)
+	defer os.RemoveAll(dir)
+	env := map[string]string{
+		"UNSPLASH_ACCESS_KEY": "key",
+		"UNSPLASH_BASE_URL":   server.URL,
+		"UNSPLASH_CONFIG":     filepath.Join(dir, "missing.json"),
+	}
+	// a missing config file given explicitly is an error
+	code, _, stderr := runCLI(env, "photo", "photo-01")
+	assert.Equal(1, code)
+	assert.Contains(stderr, "missing.json")
+	delete(env, "UNSPLASH_CONFIG")
+	env["HOME"] = dir
+
+	code, stdout, _ := runCLI(env, "photo", "photo-01")
+	assert.Equal(0, code)
+	assert.Contains(stdout, "FIELD")
+	assert.Contains(stdout, "photo-01")
+
+	code, stdout, _ = runCLI(env, "-format", "json", "search", "photos", "mountain")
+	assert.Equal(0, code)
+	var result struct {
+		Total   int `json:"total"`
+		Results []struct {
+			ID string `json:"id"`
+		} `json:"results"`
+	}
+	assert.Nil(json.Unmarshal([]byte(stdout), &result))
+	assert.Equal(true, result.Total > 0)
+
+	code, stdout, _ = runCLI(env, "search", "users", "-format", "csv", "gopher")
+	assert.Equal(0, code)
+	records, err := csv.NewReader(strings.NewReader(stdout)).ReadAll()
+	assert.Nil(err)
+	assert.Equal([]string{"username", "name", "photos", "likes", "collections"}, records[0])
+	assert.Equal("gopher", records[1][0])
+
+	code, stdout, _ = runCLI(env, "random", "-count", "3", "-orientation", "landscape", "-format", "csv")
+	assert.Equal(0, code)
+	records, _ = csv.NewReader(strings.NewReader(stdout)).ReadAll()
+	assert.Equal(4, len(records))
+
+	code, stdout, _ = runCLI(env, "user", "stats", "ferris")
+	assert.Equal(0, code)
+	assert.Contains(stdout, "downloads")
+	code, _, _ = runCLI(env, "user", "info", "ferris")
+	assert.Equal(0, code)
+	code, stdout, _ = runCLI(env, "stats", "-month")
+	assert.Equal(0, code)
+	assert.Contains(stdout, "new_photos")
+	code, _, _ = runCLI(env, "collection", "list", "-featured")
+	assert.Equal(0, code)
+
+	downloads := filepath.Join(dir, "downloads")
+	code, stdout, stderr = runCLI(env, "download", "-size", "raw", "-dir", downloads, "photo-01", "photo-02")
+	assert.Equal(0, code, stderr)
+	assert.Contains(stdout, "downloaded")
+	data, err := ioutil.ReadFile(filepath.Join(downloads, "photo-01-raw.jpg"))
+	assert.Nil(err)
+	assert.Equal(unsplashtest.ImageData("photo-01"), data)
+	code, stdout, _ = runCLI(env, "download", "-size", "raw", "-dir", downloads, "photo-01", "missing")
+	assert.Equal(1, code)
+	assert.Contains(stdout, "exists")
+	target := filepath.Join(dir, "photo.jpg")
+	code, _, stderr = runCLI(env, "download", "-size", "raw", "-o", target, "photo-01")
+	assert.Equal(0, code, stderr)
+	data, err = ioutil.ReadFile(target)
+	assert.Nil(err)
+	assert.Equal(unsplashtest.ImageData("photo-01"), data)
+	photo, _ := server.Photo("photo-01")
+	assert.Equal(true, photo.Downloads > 0)
+	// the target is only replaced with -overwrite
+	assert.Nil(ioutil.WriteFile(target, []byte("gopher"), 0644))
+	code, _, stderr = runCLI(env, "download", "-size", "raw", "-o", target, "photo-01")
+	assert.Equal(1, code)
+	assert.Contains(stderr, "-overwrite")
+	data, _ = ioutil.ReadFile(target)
+	assert.Equal("gopher", string(data))
+	code, _, stderr = runCLI(env, "download", "-size", "raw", "-overwrite", "-o", target, "photo-01")
+	assert.Equal(0, code, stderr)
+	data, _ = ioutil.ReadFile(target)
+	assert.Equal(unsplashtest.ImageData("photo-01"), data)
+	code, stdout, stderr = runCLI(env, "download", "-size", "raw", "-o", "-", "photo-02")
+	assert.Equal(0, code, stderr)
+	assert.Equal(string(unsplashtest.ImageData("photo-02")), stdout)
+	code, _, _ = runCLI(env, "download", "-o", target, "photo-01", "photo-02")
+	assert.Equal(2, code)
+
+	// writes need a token
+	code, _, stderr = runCLI(env, "collection", "create", "My gophers")
+	assert.Equal(1, code)
+	env["UNSPLASH_TOKEN"] = "token"
+	code, stdout, stderr = runCLI(env, "-format", "json", "collection", "create", "-private", "My gophers")
+	assert.Equal(0, code, stderr)
+	var collection struct {
+		ID      int  `json:"id"`
+		Private bool `json:"private"`
+	}
+	assert.Nil(json.Unmarshal([]byte(stdout), &collection))
+	assert.Equal(true, collection.Private)
+	id := strings.TrimSpace(strings.Split(strings.Split(stdout, `"id": `)[1], ",")[0])
+	code, _, stderr = runCLI(env, "collection", "add", id, "photo-05")
+	assert.Equal(0, code, stderr)
+	c, _ := server.Collection(collection.ID)
+	assert.Equal([]string{"photo-05"}, c.PhotoIDs)
+	code, _, _ = runCLI(env, "collection", "remove", id, "photo-05")
+	assert.Equal(0, code)
+	c, _ = server.Collection(collection.ID)
+	assert.Equal(0, len(c.PhotoIDs))
+}
+
+func TestCLIConfig(T *testing.T) {
+	assert := assert.New(T)
+	server := unsplashtest.NewServer()
+	defer server.Close()
+	dir, err := ioutil.TempDir("", "unsplash-cli")
+	assert.Nil(err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "config.json")
+	config := `{"access_key": "key", "base_url": "` + server.URL + `"}`
+	assert.Nil(ioutil.WriteFile(path, []byte(config), 0600))
+
+	code, stdout, _ := runCLI(map[string]string{}, "-config", path, "stats")
+	assert.Equal(0, code)
+	assert.Contains(stdout, "photographers")
+	requests := server.Requests()
+	assert.Equal("Client-ID key", requests[len(requests)-1].Header.Get("Authorization"))
+
+	code, _, stderr := runCLI(map[string]string{"HOME": dir, "XDG_CONFIG_HOME": dir}, "stats")
+	assert.Equal(1, code)
+	assert.Contains(stderr, "no credentials")
+
+	code, _, _ = runCLI(nil)
+	assert.Equal(2, code)
+	code, _, _ = runCLI(nil, "bogus")
+	assert.Equal(2, code)
+	code, _, _ = runCLI(nil, "photo")
+	assert.Equal(2, code)
+	code, _, _ = runCLI(nil, "collection", "bogus")
+	assert.Equal(2, code)
+	code, _, stderr = runCLI(map[string]string{"UNSPLASH_CONFIG": path}, "-format", "xml", "stats")
+	assert.Equal(1, code)
+	assert.Contains(stderr, "xml")
+}
@@ -0,0 +1,204 @@
+// Copyright (c) 2017 Hardik Bagdi <hbagdi1@binghamton.edu>
+//
+// MIT License
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/hbagdi/go-unsplash/unsplash"
+)
+
+const (
+	formatTable = "table"
+	formatJSON  = "json"
+	formatCSV   = "csv"
+)
+
+// table is the tabular form of a result, used by the table and csv formats.
+type table struct {
+	header []string
+	rows   [][]string
+}
+
+// print writes v in JSON, or t as a table or CSV, depending on the format.
+func (a *app) print(v interface{}, t table) error {
+	switch a.format {
+	case formatJSON:
+		enc := json.NewEncoder(a.stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+	case formatCSV:
+		w := csv.NewWriter(a.stdout)
+		w.Write(t.header)
+		w.WriteAll(t.rows)
+		return w.Error()
+	default:
+		w := tabwriter.NewWriter(a.stdout, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, strings.ToUpper(strings.Join(t.header, "\t")))
+		for _, row := range t.rows {
+			cells := make([]string, len(row))
+			for i, cell := range row {
+				cells[i] = strings.NewReplacer("\t", " ", "\n", " ").Replace(cell)
+			}
+			fmt.Fprintln(w, strings.Join(cells, "\t"))
+		}
+		return w.Flush()
+	}
+}
+
+// fields returns a two column table for a single object.
+func fields(kv ...string) table {
+	t := table{header: []string{"field", "value"}}
+	for i := 0; i+1 < len(kv); i += 2 {
+		t.rows = append(t.rows, []string{kv[i], kv[i+1]})
+	}
+	return t
+}
+
+func str(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func num(n *int) string {
+	if n == nil {
+		return ""
+	}
+	return strconv.Itoa(*n)
+}
+
+func boolean(b *bool) string {
+	if b == nil {
+		return ""
+	}
+	return strconv.FormatBool(*b)
+}
+
+func date(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+func link(u *unsplash.URL) string {
+	if u == nil {
+		return ""
+	}
+	return u.String()
+}
+
+func username(u *unsplash.User) string {
+	if u == nil {
+		return ""
+	}
+	return str(u.Username)
+}
+
+func photosTable(photos []unsplash.Photo) table {
+	t := table{header: []string{"id", "width", "height", "likes", "photographer", "description"}}
+	for _, p := range photos {
+		description := str(p.Description)
+		if description == "" {
+			description = str(p.AltDescription)
+		}
+		t.rows = append(t.rows, []string{str(p.ID), num(p.Width), num(p.Height),
+			num(p.Likes), username(p.Photographer), description})
+	}
+	return t
+}
+
+func usersTable(users []unsplash.User) table {
+	t := table{header: []string{"username", "name", "photos", "likes", "collections"}}
+	for _, u := range users {
+		t.rows = append(t.rows, []string{str(u.Username), str(u.Name), num(u.TotalPhotos),
+			num(u.TotalLikes), num(u.TotalCollections)})
+	}
+	return t
+}
+
+func collectionsTable(collections []unsplash.Collection) table {
+	t := table{header: []string{"id", "title", "photos", "private", "owner"}}
+	for _, c := range collections {
+		t.rows = append(t.rows, []string{num(c.ID), str(c.Title), num(c.TotalPhotos),
+			boolean(c.Private), username(c.Photographer)})
+	}
+	return t
+}
+
+func photoTable(p *unsplash.Photo) table {
+	var raw, html string
+	if p.Urls != nil {
+		raw = link(p.Urls.Raw)
+	}
+	if p.Links != nil {
+		html = link(p.Links.HTML)
+	}
+	return fields(
+		"id", str(p.ID),
+		"description", str(p.Description),
+		"alt_description", str(p.AltDescription),
+		"width", num(p.Width),
+		"height", num(p.Height),
+		"color", str(p.Color),
+		"likes", num(p.Likes),
+		"downloads", num(p.Downloads),
+		"photographer", username(p.Photographer),
+		"created_at", date(p.CreatedAt),
+		"url", html,
+		"raw", raw,
+	)
+}
+
+func userTable(u *unsplash.User) table {
+	return fields(
+		"username", str(u.Username),
+		"name", str(u.Name),
+		"bio", str(u.Bio),
+		"location", str(u.Location),
+		"portfolio", link(u.PortfolioURL),
+		"photos", num(u.TotalPhotos),
+		"likes", num(u.TotalLikes),
+		"collections", num(u.TotalCollections),
+	)
+}
+
+func collectionTable(c *unsplash.Collection) table {
+	return fields(
+		"id", num(c.ID),
+		"title", str(c.Title),
+		"description", str(c.Description),
+		"photos", num(c.TotalPhotos),
+		"private", boolean(c.Private),
+		"owner", username(c.Photographer),
+	)
+}
@@ -23,7 +23,13 @@
 
 package unsplash
 
+import "strings"
+
+// Scope is a permission scope for the Unsplash API for OAuth.
+type Scope string
+
 //These are permission scopes for the Unsplash API for OAuth.
+//They are untyped so that they can be used as a Scope or a string.
 const (
 	//Public is default; gives access to read public data.
 	Public = "public"
@@ -44,3 +50,52 @@ const (
 	//WriteCollections gives access to create and update a users's collections.
 	WriteCollections = "write_collections"
 )
+
+var allScopes = Scopes{Public, ReadUser, WriteUser, ReadPhotos, WritePhotos,
+	WriteLikes, WriteFollowers, ReadCollections, WriteCollections}
+
+// Scopes is a set of permission scopes.
+type Scopes []Scope
+
+// ParseScopes parses a space separated list of scopes,
+// as found in the scope field of a token response.
+func ParseScopes(s string) Scopes {
+	var scopes Scopes
+	for _, scope := range strings.Fields(s) {
+		scopes = append(scopes, Scope(scope))
+	}
+	return scopes
+}
+
+// Has reports whether scope is in the set.
+func (s Scopes) Has(scope Scope) bool {
+	for _, val := range s {
+		if val == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// Valid reports whether all the scopes in the set are known to the API.
+func (s Scopes) Valid() bool {
+	for _, scope := range s {
+		if !allScopes.Has(scope) {
+			return false
+		}
+	}
+	return true
+}
+
+// Strings returns the scopes as a slice of strings.
+func (s Scopes) Strings() []string {
+	strs := make([]string, len(s))
+	for i, scope := range s {
+		strs[i] = string(scope)
+	}
+	return strs
+}
+
+func (s Scopes) String() string {
+	return strings.Join(s.Strings(), " ")
+}
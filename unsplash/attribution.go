@@ -0,0 +1,114 @@
+// Copyright (c) 2017 Hardik Bagdi <hbagdi1@binghamton.edu>
+//
+// MIT License
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package unsplash
+
+import (
+	"fmt"
+	"html"
+	"net/url"
+	"strings"
+)
+
+// unsplashURL is linked to when a photo has no page of its own.
+const unsplashURL = "https://unsplash.com/"
+
+// Attribution credits the photographer of a photo and Unsplash, as required
+// by the API guidelines. The links carry the utm_source and utm_medium
+// parameters identifying the application.
+type Attribution struct {
+	PhotographerName string
+	PhotographerURL  string
+	UnsplashURL      string
+}
+
+// Attribution returns the Attribution of the photo for the application
+// called appName.
+func (p *Photo) Attribution(appName string) (*Attribution, error) {
+	if strings.TrimSpace(appName) == "" {
+		return nil, &IllegalArgumentError{ErrString: "Application name cannot be empty"}
+	}
+	user := p.Photographer
+	if user == nil || user.Links == nil || user.Links.HTML == nil || user.Links.HTML.URL == nil {
+		return nil, &IllegalArgumentError{ErrString: "Photo has no photographer profile"}
+	}
+	var name string
+	switch {
+	case user.Name != nil && *user.Name != "":
+		name = *user.Name
+	case user.Username != nil:
+		name = *user.Username
+	default:
+		return nil, &IllegalArgumentError{ErrString: "Photo has no photographer name"}
+	}
+	photoURL, _ := url.Parse(unsplashURL)
+	if p.Links != nil && p.Links.HTML != nil && p.Links.HTML.URL != nil {
+		photoURL = p.Links.HTML.URL
+	}
+	return &Attribution{
+		PhotographerName: name,
+		PhotographerURL:  referral(user.Links.HTML.URL, appName),
+		UnsplashURL:      referral(photoURL, appName),
+	}, nil
+}
+
+// referral returns u with the UTM parameters of appName.
+func referral(u *url.URL, appName string) string {
+	ref := *u
+	values := ref.Query()
+	values.Set("utm_source", appName)
+	values.Set("utm_medium", "referral")
+	ref.RawQuery = values.Encode()
+	return ref.String()
+}
+
+// Text renders the attribution as plain text.
+func (a *Attribution) Text() string {
+	return fmt.Sprintf("Photo by %v on Unsplash", a.PhotographerName)
+}
+
+func (a *Attribution) String() string {
+	return a.Text()
+}
+
+// HTML renders the attribution as an HTML fragment.
+func (a *Attribution) HTML() string {
+	return fmt.Sprintf(`Photo by <a href="%v">%v</a> on <a href="%v">Unsplash</a>`,
+		html.EscapeString(a.PhotographerURL), html.EscapeString(a.PhotographerName),
+		html.EscapeString(a.UnsplashURL))
+}
+
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`, "`", "\\`", `*`, `\*`, `_`, `\_`, `[`, `\[`, `]`, `\]`,
+	`(`, `\(`, `)`, `\)`, `<`, `\<`, `>`, `\>`, `#`, `\#`, `!`, `\!`,
+)
+
+// parentheses and spaces would end a Markdown link target early
+var markdownURLEscaper = strings.NewReplacer(`(`, `%28`, `)`, `%29`, ` `, `%20`)
+
+// Markdown renders the attribution as Markdown.
+func (a *Attribution) Markdown() string {
+	return fmt.Sprintf("Photo by [%v](%v) on [Unsplash](%v)",
+		markdownEscaper.Replace(a.PhotographerName), markdownURLEscaper.Replace(a.PhotographerURL),
+		markdownURLEscaper.Replace(a.UnsplashURL))
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2017 Hardik Bagdi <hbagdi1@binghamton.edu>
+//
+// MIT License
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package unsplash
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAttribution(T *testing.T) {
+	assert := assert.New(T)
+	var photo Photo
+	data := `{"id": "gopherPhoto",
+		"links": {"html": "https://unsplash.com/photos/gopherPhoto"},
+		"user": {"username": "gopher", "name": "Go <Gopher> [Jr.]",
+			"links": {"html": "https://unsplash.com/@gopher"}}}`
+	assert.Nil(json.Unmarshal([]byte(data), &photo))
+
+	a, err := photo.Attribution("My App")
+	assert.Nil(err)
+	assert.Equal("https://unsplash.com/@gopher?utm_medium=referral&utm_source=My+App", a.PhotographerURL)
+	assert.Equal("https://unsplash.com/photos/gopherPhoto?utm_medium=referral&utm_source=My+App", a.UnsplashURL)
+	assert.Equal("Photo by Go <Gopher> [Jr.] on Unsplash", a.Text())
+	assert.Equal(a.Text(), a.String())
+	assert.Equal(`Photo by <a href="https://unsplash.com/@gopher?utm_medium=referral&amp;utm_source=My+App">`+
+		`Go &lt;Gopher&gt; [Jr.]</a> on `+
+		`<a href="https://unsplash.com/photos/gopherPhoto?utm_medium=referral&amp;utm_source=My+App">Unsplash</a>`,
+		a.HTML())
+	assert.Equal(`Photo by [Go \<Gopher\> \[Jr.\]](https://unsplash.com/@gopher?utm_medium=referral&utm_source=My+App)`+
+		` on [Unsplash](https://unsplash.com/photos/gopherPhoto?utm_medium=referral&utm_source=My+App)`,
+		a.Markdown())
+
+	//falls back to the username and the Unsplash home page
+	photo.Photographer.Name = nil
+	photo.Links = nil
+	a, err = photo.Attribution("gopher_app")
+	assert.Nil(err)
+	assert.Equal("gopher", a.PhotographerName)
+	assert.Equal("https://unsplash.com/?utm_medium=referral&utm_source=gopher_app", a.UnsplashURL)
+	assert.Equal(`Photo by [gopher](https://unsplash.com/@gopher?utm_medium=referral&utm_source=gopher_app)`+
+		` on [Unsplash](https://unsplash.com/?utm_medium=referral&utm_source=gopher_app)`, a.Markdown())
+
+	_, err = photo.Attribution(" ")
+	assert.NotNil(err)
+	_, err = (&Photo{}).Attribution("gopher_app")
+	assert.NotNil(err)
+	_, ok := err.(*IllegalArgumentError)
+	assert.Equal(true, ok)
+}
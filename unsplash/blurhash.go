@@ -0,0 +1,183 @@
+// Copyright (c) 2017 Hardik Bagdi <hbagdi1@binghamton.edu>
+//
+// MIT License
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package unsplash
+
+import (
+	"bytes"
+	"encoding/base64"
+	"image"
+	"image/png"
+	"math"
+	"strings"
+)
+
+// BlurHash decoding, see https://github.com/woltapp/blurhash
+
+const base83Chars = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz#$%*+,-.:;=?@[]^_{|}~"
+
+func decodeBase83(s string) (int, error) {
+	value := 0
+	for _, c := range s {
+		digit := strings.IndexRune(base83Chars, c)
+		if digit < 0 {
+			return 0, &IllegalArgumentError{ErrString: "Invalid BlurHash character " + string(c)}
+		}
+		value = value*83 + digit
+	}
+	return value, nil
+}
+
+func sRGBToLinear(value int) float64 {
+	v := float64(value) / 255
+	if v <= 0.04045 {
+		return v / 12.92
+	}
+	return math.Pow((v+0.055)/1.055, 2.4)
+}
+
+func linearToSRGB(value float64) uint8 {
+	v := math.Max(0, math.Min(1, value))
+	if v <= 0.0031308 {
+		return uint8(math.Round(v * 12.92 * 255))
+	}
+	return uint8(math.Round((1.055*math.Pow(v, 1/2.4) - 0.055) * 255))
+}
+
+func signPow(value, exp float64) float64 {
+	return math.Copysign(math.Pow(math.Abs(value), exp), value)
+}
+
+// maxBlurHashPixels bounds the size of decoded BlurHash images,
+// so that untrusted sizes can't exhaust memory.
+const maxBlurHashPixels = 1 << 20
+
+// DecodeBlurHash decodes a BlurHash into an image of width x height pixels.
+// Placeholders are usually decoded at a small size, like 32x32,
+// and scaled up by the browser. Images larger than 1<<20 pixels
+// are rejected.
+func DecodeBlurHash(hash string, width, height int) (image.Image, error) {
+	if width <= 0 || height <= 0 {
+		return nil, &IllegalArgumentError{ErrString: "Width and height must be positive"}
+	}
+	if width > maxBlurHashPixels || height > maxBlurHashPixels || width*height > maxBlurHashPixels {
+		return nil, &IllegalArgumentError{ErrString: "Width and height are too large"}
+	}
+	if len(hash) < 6 {
+		return nil, &IllegalArgumentError{ErrString: "BlurHash is too short"}
+	}
+	sizeFlag, err := decodeBase83(hash[:1])
+	if err != nil {
+		return nil, err
+	}
+	numX, numY := sizeFlag%9+1, sizeFlag/9+1
+	if len(hash) != 4+2*numX*numY {
+		return nil, &IllegalArgumentError{ErrString: "BlurHash length doesn't match its size flag"}
+	}
+	quantisedMax, err := decodeBase83(hash[1:2])
+	if err != nil {
+		return nil, err
+	}
+	maxValue := float64(quantisedMax+1) / 166
+
+	colors := make([][3]float64, numX*numY)
+	dc, err := decodeBase83(hash[2:6])
+	if err != nil {
+		return nil, err
+	}
+	colors[0] = [3]float64{sRGBToLinear(dc >> 16), sRGBToLinear(dc >> 8 & 255), sRGBToLinear(dc & 255)}
+	for i := 1; i < len(colors); i++ {
+		ac, err := decodeBase83(hash[4+i*2 : 6+i*2])
+		if err != nil {
+			return nil, err
+		}
+		colors[i] = [3]float64{
+			signPow(float64(ac/(19*19)-9)/9, 2) * maxValue,
+			signPow(float64(ac/19%19-9)/9, 2) * maxValue,
+			signPow(float64(ac%19-9)/9, 2) * maxValue,
+		}
+	}
+
+	cosX := cosines(width, numX)
+	cosY := cosines(height, numY)
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			var r, g, b float64
+			for j := 0; j < numY; j++ {
+				for i := 0; i < numX; i++ {
+					basis := cosX[x*numX+i] * cosY[y*numY+j]
+					c := colors[i+j*numX]
+					r += c[0] * basis
+					g += c[1] * basis
+					b += c[2] * basis
+				}
+			}
+			pix := img.Pix[y*img.Stride+x*4:]
+			pix[0], pix[1], pix[2], pix[3] = linearToSRGB(r), linearToSRGB(g), linearToSRGB(b), 255
+		}
+	}
+	return img, nil
+}
+
+// cosines returns cos(pi*p*c/size) for every pixel p and component c.
+func cosines(size, components int) []float64 {
+	table := make([]float64, size*components)
+	for p := 0; p < size; p++ {
+		for c := 0; c < components; c++ {
+			table[p*components+c] = math.Cos(math.Pi * float64(p) * float64(c) / float64(size))
+		}
+	}
+	return table
+}
+
+// BlurHashDataURI decodes a BlurHash into a PNG of width x height pixels
+// and returns it as a data URI, ready to be inlined in a page.
+func BlurHashDataURI(hash string, width, height int) (string, error) {
+	img, err := DecodeBlurHash(hash, width, height)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return "", err
+	}
+	return "data:image/png;base64," + base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+// Placeholder decodes the BlurHash of the photo into an image of
+// width x height pixels.
+func (p *Photo) Placeholder(width, height int) (image.Image, error) {
+	if p.BlurHash == nil {
+		return nil, &IllegalArgumentError{ErrString: "Photo has no BlurHash"}
+	}
+	return DecodeBlurHash(*p.BlurHash, width, height)
+}
+
+// PlaceholderDataURI is like Placeholder but returns a PNG data URI.
+func (p *Photo) PlaceholderDataURI(width, height int) (string, error) {
+	if p.BlurHash == nil {
+		return "", &IllegalArgumentError{ErrString: "Photo has no BlurHash"}
+	}
+	return BlurHashDataURI(*p.BlurHash, width, height)
+}
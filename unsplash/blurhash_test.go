@@ -0,0 +1,124 @@
+// Copyright (c) 2017 Hardik Bagdi <hbagdi1@binghamton.edu>
+//
+// MIT License
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package unsplash
+
+import (
+	"bytes"
+	"encoding/base64"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func encodeBase83(value, length int) string {
+	buf := make([]byte, length)
+	for i := length - 1; i >= 0; i-- {
+		buf[i] = base83Chars[value%83]
+		value /= 83
+	}
+	return string(buf)
+}
+
+func TestBase83(T *testing.T) {
+	assert := assert.New(T)
+	for _, value := range []int{0, 1, 82, 83, 6888, 0xFF8800} {
+		decoded, err := decodeBase83(encodeBase83(value, 4))
+		assert.Nil(err)
+		assert.Equal(value, decoded)
+	}
+	_, err := decodeBase83("ab/")
+	assert.NotNil(err)
+}
+
+func TestDecodeBlurHash(T *testing.T) {
+	assert := assert.New(T)
+
+	//a single DC component decodes to a flat image of that color
+	img, err := DecodeBlurHash("00"+encodeBase83(0xFF8800, 4), 4, 3)
+	assert.Nil(err)
+	assert.Equal(4, img.Bounds().Dx())
+	assert.Equal(3, img.Bounds().Dy())
+	for y := 0; y < 3; y++ {
+		for x := 0; x < 4; x++ {
+			r, g, b, a := img.At(x, y).RGBA()
+			assert.Equal([]uint32{0xFF, 0x88, 0x00, 0xFF}, []uint32{r >> 8, g >> 8, b >> 8, a >> 8})
+		}
+	}
+
+	//a horizontal AC component makes the left side brighter than the right
+	hash := encodeBase83(1, 1) + encodeBase83(82, 1) + encodeBase83(0x808080, 4) + encodeBase83(18*19*19+18*19+18, 2)
+	img, err = DecodeBlurHash(hash, 8, 1)
+	assert.Nil(err)
+	left, _, _, _ := img.At(0, 0).RGBA()
+	right, _, _, _ := img.At(7, 0).RGBA()
+	assert.True(left > right)
+
+	img, err = DecodeBlurHash("LEHV6nWB2yk8pyo0adR*.7kCMdnj", 32, 32)
+	assert.Nil(err)
+	assert.Equal(32, img.Bounds().Dx())
+
+	for _, invalid := range []string{"", "LEHV6", "LEHV6nWB2yk8pyo0adR*.7kCMdn", "LEHV6nWB2yk8pyo0adR*.7kCMdn/"} {
+		_, err = DecodeBlurHash(invalid, 32, 32)
+		assert.NotNil(err, invalid)
+	}
+	_, err = DecodeBlurHash("LEHV6nWB2yk8pyo0adR*.7kCMdnj", 0, 32)
+	assert.NotNil(err)
+
+	//sizes are bounded
+	img, err = DecodeBlurHash("00"+encodeBase83(0xFF8800, 4), 1024, 1024)
+	assert.Nil(err)
+	for _, size := range [][2]int{{1025, 1024}, {1 << 21, 1}, {1 << 40, 1 << 40}} {
+		_, err = DecodeBlurHash("LEHV6nWB2yk8pyo0adR*.7kCMdnj", size[0], size[1])
+		_, ok := err.(*IllegalArgumentError)
+		assert.Equal(true, ok, size)
+	}
+}
+
+func TestPlaceholder(T *testing.T) {
+	assert := assert.New(T)
+	hash := "LEHV6nWB2yk8pyo0adR*.7kCMdnj"
+	photo := &Photo{BlurHash: &hash}
+
+	img, err := photo.Placeholder(16, 8)
+	assert.Nil(err)
+	assert.Equal(16, img.Bounds().Dx())
+
+	uri, err := photo.PlaceholderDataURI(16, 8)
+	assert.Nil(err)
+	assert.True(strings.HasPrefix(uri, "data:image/png;base64,"))
+	data, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(uri, "data:image/png;base64,"))
+	assert.Nil(err)
+	decoded, err := png.Decode(bytes.NewReader(data))
+	assert.Nil(err)
+	r1, g1, b1, _ := img.At(3, 5).RGBA()
+	r2, g2, b2, _ := decoded.At(3, 5).RGBA()
+	assert.Equal([]uint32{r1, g1, b1}, []uint32{r2, g2, b2})
+
+	_, err = (&Photo{}).Placeholder(16, 8)
+	assert.NotNil(err)
+	_, err = (&Photo{}).PlaceholderDataURI(16, 8)
+	assert.NotNil(err)
+}
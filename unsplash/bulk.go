@@ -0,0 +1,273 @@
+// Copyright (c) 2017 Hardik Bagdi <hbagdi1@binghamton.edu>
+//
+// MIT License
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package unsplash
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+)
+
+// defaultWorkers is the number of concurrent requests made by FetchAll
+// when no worker count is given.
+const defaultWorkers = 4
+
+// fetchAll requests the pages following the current one, up to the last
+// page or the pager's limits, using up to workers concurrent requests.
+// The last page is taken from the Response of the page already fetched.
+// fetch is called once for every page and must be safe for concurrent use.
+// fetchAll returns the range of pages requested; the pages that couldn't be
+// fetched are reported in a *BulkError.
+func (p *pager) fetchAll(workers int, fetch func(ctx context.Context, page int) (int, *Response, error)) (int, int, error) {
+	first := p.page
+	last := first - 1
+	if workers < 0 {
+		return first, last, &IllegalArgumentError{ErrString: "Number of workers cannot be negative."}
+	}
+	if workers == 0 {
+		workers = defaultWorkers
+	}
+	defer func() {
+		// whatever happens, the pager is done
+		p.last = true
+		p.index = 0
+		p.count = 0
+	}()
+	if p.last || p.resp == nil || p.budget() == 0 {
+		return first, last, nil
+	}
+	last = p.resp.LastPage
+	if last < first {
+		// no idea how many pages there are, fall back to one at a time
+		left := p.budget()
+		for page := first; p.limits.MaxPages == 0 || p.pages < p.limits.MaxPages; page++ {
+			n, resp, err := fetch(p.ctx, page)
+			if err != nil {
+				return first, page, &BulkError{Errors: []*PageError{{Page: page, Err: err}}}
+			}
+			p.page++
+			p.pages++
+			left -= n
+			if !resp.HasNextPage || n == 0 || (p.limits.MaxItems > 0 && left <= 0) {
+				break
+			}
+		}
+		return first, p.page - 1, nil
+	}
+	if p.limits.MaxPages > 0 && last-first+1 > p.limits.MaxPages-p.pages {
+		last = first - 1 + p.limits.MaxPages - p.pages
+	}
+	if budget := p.budget(); budget > 0 && p.count > 0 {
+		// assume the following pages are as big as the current one
+		needed := (budget + p.count - 1) / p.count
+		if last-first+1 > needed {
+			last = first - 1 + needed
+		}
+	}
+	p.page = last + 1
+	p.pages += last - first + 1
+
+	ctx, cancel := context.WithCancel(p.ctx)
+	defer cancel()
+	var (
+		mu   sync.Mutex
+		errs []*PageError
+		// stop is the error that made fetchAll give up on remaining pages
+		stop error
+		wg   sync.WaitGroup
+	)
+	pages := make(chan int)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for page := range pages {
+				_, _, err := fetch(ctx, page)
+				if err == nil {
+					continue
+				}
+				mu.Lock()
+				var rateLimitErr *RateLimitError
+				if stop == nil && errors.As(err, &rateLimitErr) {
+					// no point in going on, the other requests would fail as well
+					stop = err
+					cancel()
+				} else if stop != nil && ctx.Err() != nil {
+					err = stop
+				}
+				errs = append(errs, &PageError{Page: page, Err: err})
+				mu.Unlock()
+			}
+		}()
+	}
+	page := first
+	for ; page <= last; page++ {
+		select {
+		case pages <- page:
+			continue
+		case <-ctx.Done():
+		}
+		break
+	}
+	close(pages)
+	wg.Wait()
+
+	if page <= last {
+		if stop == nil {
+			stop = ctx.Err()
+		}
+		for ; page <= last; page++ {
+			errs = append(errs, &PageError{Page: page, Err: stop})
+		}
+	}
+	if len(errs) == 0 {
+		return first, last, nil
+	}
+	sort.Slice(errs, func(i, j int) bool { return errs[i].Page < errs[j].Page })
+	return first, last, &BulkError{Errors: errs}
+}
+
+// take returns at most the number of items the pager may still return
+// out of n, and accounts for them.
+func (p *pager) take(n int) int {
+	if budget := p.budget(); budget >= 0 && n > budget {
+		n = budget
+	}
+	p.items += n
+	return n
+}
+
+// FetchAll returns all the photos the pager hasn't returned yet, within the
+// limits of the pager.
+// After the first page, the remaining pages are requested concurrently by up
+// to workers goroutines, 4 if workers is 0. Photos are returned in order.
+// Requests go through the rate limiter of the client, if any, and FetchAll
+// stops requesting pages once the rate limit is exhausted.
+// If some pages couldn't be fetched, the photos of the other pages are
+// returned along with a *BulkError.
+func (p *PhotoPager) FetchAll(workers int) ([]Photo, error) {
+	all := make([]Photo, 0)
+	if p.err != nil {
+		return all, p.err
+	}
+	if p.pages == 0 {
+		if !p.Next() {
+			return all, p.err
+		}
+		p.index--
+		p.items--
+	}
+	all = append(all, p.photos[p.index:p.index+p.take(p.count-p.index)]...)
+	var mu sync.Mutex
+	pages := make(map[int][]Photo)
+	first, last, err := p.fetchAll(workers, func(ctx context.Context, page int) (int, *Response, error) {
+		photos, resp, err := p.fetch(ctx, page)
+		if err != nil {
+			return 0, nil, err
+		}
+		mu.Lock()
+		pages[page] = *photos
+		mu.Unlock()
+		return len(*photos), resp, nil
+	})
+	for page := first; page <= last; page++ {
+		photos := pages[page]
+		all = append(all, photos[:p.take(len(photos))]...)
+	}
+	p.err = err
+	return all, err
+}
+
+// FetchAll returns all the collections the pager hasn't returned yet,
+// within the limits of the pager.
+// It works like PhotoPager.FetchAll.
+func (p *CollectionPager) FetchAll(workers int) ([]Collection, error) {
+	all := make([]Collection, 0)
+	if p.err != nil {
+		return all, p.err
+	}
+	if p.pages == 0 {
+		if !p.Next() {
+			return all, p.err
+		}
+		p.index--
+		p.items--
+	}
+	all = append(all, p.collections[p.index:p.index+p.take(p.count-p.index)]...)
+	var mu sync.Mutex
+	pages := make(map[int][]Collection)
+	first, last, err := p.fetchAll(workers, func(ctx context.Context, page int) (int, *Response, error) {
+		collections, resp, err := p.fetch(ctx, page)
+		if err != nil {
+			return 0, nil, err
+		}
+		mu.Lock()
+		pages[page] = *collections
+		mu.Unlock()
+		return len(*collections), resp, nil
+	})
+	for page := first; page <= last; page++ {
+		collections := pages[page]
+		all = append(all, collections[:p.take(len(collections))]...)
+	}
+	p.err = err
+	return all, err
+}
+
+// FetchAll returns all the users the pager hasn't returned yet,
+// within the limits of the pager.
+// It works like PhotoPager.FetchAll.
+func (p *UserPager) FetchAll(workers int) ([]User, error) {
+	all := make([]User, 0)
+	if p.err != nil {
+		return all, p.err
+	}
+	if p.pages == 0 {
+		if !p.Next() {
+			return all, p.err
+		}
+		p.index--
+		p.items--
+	}
+	all = append(all, p.users[p.index:p.index+p.take(p.count-p.index)]...)
+	var mu sync.Mutex
+	pages := make(map[int][]User)
+	first, last, err := p.fetchAll(workers, func(ctx context.Context, page int) (int, *Response, error) {
+		users, resp, err := p.fetch(ctx, page)
+		if err != nil {
+			return 0, nil, err
+		}
+		mu.Lock()
+		pages[page] = *users
+		mu.Unlock()
+		return len(*users), resp, nil
+	})
+	for page := first; page <= last; page++ {
+		users := pages[page]
+		all = append(all, users[:p.take(len(users))]...)
+	}
+	p.err = err
+	return all, err
+}
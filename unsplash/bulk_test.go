@@ -0,0 +1,216 @@
+// Copyright (c) 2017 Hardik Bagdi <hbagdi1@binghamton.edu>
+//
+// MIT License
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package unsplash
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type bulkServer struct {
+	*httptest.Server
+	mu       sync.Mutex
+	requests int
+	inFlight int
+	peak     int
+	// status makes the server fail the page with the status code
+	status map[int]int
+	// noLast leaves out rel="last" from the Link header
+	noLast bool
+}
+
+func newBulkServer() *bulkServer {
+	s := &bulkServer{status: make(map[int]int)}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		s.mu.Lock()
+		s.requests++
+		s.inFlight++
+		if s.inFlight > s.peak {
+			s.peak = s.inFlight
+		}
+		status := s.status[page]
+		s.mu.Unlock()
+		time.Sleep(5 * time.Millisecond)
+		s.mu.Lock()
+		s.inFlight--
+		s.mu.Unlock()
+
+		if status == 403 {
+			w.Header().Set("X-Ratelimit-Limit", "50")
+			w.Header().Set("X-Ratelimit-Remaining", "0")
+		}
+		if status != 0 {
+			w.WriteHeader(status)
+			return
+		}
+		link := fmt.Sprintf(`<%v/photos?page=1>; rel="first"`, s.URL)
+		if !s.noLast {
+			link += fmt.Sprintf(`, <%v/photos?page=10>; rel="last"`, s.URL)
+		}
+		if page < 10 {
+			link += fmt.Sprintf(`, <%v/photos?page=%d>; rel="next"`, s.URL, page+1)
+		}
+		w.Header().Set("Link", link)
+		fmt.Fprintf(w, `[{"id": "%d-1"}, {"id": "%d-2"}, {"id": "%d-3"}]`, page, page, page)
+	}))
+	return s
+}
+
+func ids(photos []Photo) []string {
+	ids := make([]string, len(photos))
+	for i, photo := range photos {
+		ids[i] = *photo.ID
+	}
+	return ids
+}
+
+func expectedIDs(from, to int) []string {
+	var ids []string
+	for page := from; page <= to; page++ {
+		for i := 1; i <= 3; i++ {
+			ids = append(ids, fmt.Sprintf("%d-%d", page, i))
+		}
+	}
+	return ids
+}
+
+func TestFetchAll(T *testing.T) {
+	assert := assert.New(T)
+	log.SetOutput(ioutil.Discard)
+	server := newBulkServer()
+	defer server.Close()
+	unsplash := New(nil, WithBaseURL(server.URL))
+	ctx := context.Background()
+
+	photos, err := unsplash.Users.PhotosPager(ctx, "gopher", nil, nil).FetchAll(3)
+	assert.Nil(err)
+	assert.Equal(expectedIDs(1, 10), ids(photos))
+	assert.Equal(10, server.requests)
+	assert.True(server.peak > 1)
+	assert.True(server.peak <= 3)
+
+	// limits are honoured, and no more pages than needed are requested
+	server.requests = 0
+	pager := unsplash.Photos.AllPager(ctx, nil, &PagerOpt{MaxItems: 7})
+	photos, err = pager.FetchAll(0)
+	assert.Nil(err)
+	assert.Equal(expectedIDs(1, 3)[:7], ids(photos))
+	assert.Equal(3, server.requests)
+	assert.Equal(false, pager.Next())
+
+	server.requests = 0
+	photos, err = unsplash.Photos.AllPager(ctx, &ListOpt{Page: 4}, &PagerOpt{MaxPages: 2}).FetchAll(0)
+	assert.Nil(err)
+	assert.Equal(expectedIDs(4, 5), ids(photos))
+	assert.Equal(2, server.requests)
+
+	// items already returned by Next are left out
+	pager = unsplash.Photos.AllPager(ctx, nil, nil)
+	assert.Equal(true, pager.Next())
+	assert.Equal(true, pager.Next())
+	photos, err = pager.FetchAll(0)
+	assert.Nil(err)
+	assert.Equal(expectedIDs(1, 10)[2:], ids(photos))
+
+	// without a last page, pages are fetched one at a time
+	server.noLast = true
+	server.peak = 0
+	photos, err = unsplash.Photos.AllPager(ctx, nil, nil).FetchAll(0)
+	assert.Nil(err)
+	assert.Equal(expectedIDs(1, 10), ids(photos))
+	assert.Equal(1, server.peak)
+	server.noLast = false
+
+	_, err = unsplash.Photos.AllPager(ctx, nil, nil).FetchAll(-1)
+	_, ok := err.(*IllegalArgumentError)
+	assert.Equal(true, ok)
+}
+
+func TestFetchAllPartialFailure(T *testing.T) {
+	assert := assert.New(T)
+	log.SetOutput(ioutil.Discard)
+	server := newBulkServer()
+	defer server.Close()
+	unsplash := New(nil, WithBaseURL(server.URL))
+	ctx := context.Background()
+
+	server.status[3] = 500
+	server.status[7] = 404
+	pager := unsplash.Photos.AllPager(ctx, nil, nil)
+	photos, err := pager.FetchAll(2)
+	var expected []string
+	expected = append(expected, expectedIDs(1, 2)...)
+	expected = append(expected, expectedIDs(4, 6)...)
+	expected = append(expected, expectedIDs(8, 10)...)
+	assert.Equal(expected, ids(photos))
+	bulkErr, ok := err.(*BulkError)
+	assert.Equal(true, ok)
+	assert.Equal(2, len(bulkErr.Errors))
+	assert.Equal(3, bulkErr.Errors[0].Page)
+	assert.Equal(7, bulkErr.Errors[1].Page)
+	assert.Equal(err, pager.Err())
+	var nfe *NotFoundError
+	assert.Equal(true, errors.As(bulkErr.Errors[1], &nfe))
+	nfe = nil
+	assert.Equal(true, errors.As(err, &nfe))
+	assert.Equal(404, nfe.ErrorResponse.StatusCode)
+	var pageErr *PageError
+	assert.Equal(true, errors.As(err, &pageErr))
+	assert.Equal(3, pageErr.Page)
+	assert.Equal(true, bulkErr.Is(&ErrorResponse{StatusCode: 404}))
+	assert.Equal(false, bulkErr.Is(&ErrorResponse{StatusCode: 401}))
+	assert.Equal(true, errors.Is(err, &ErrorResponse{StatusCode: 500}))
+
+	// the rate limit stops everything
+	server.status = map[int]int{4: 403}
+	photos, err = unsplash.Photos.AllPager(ctx, nil, nil).FetchAll(1)
+	assert.Equal(expectedIDs(1, 3), ids(photos))
+	bulkErr, ok = err.(*BulkError)
+	assert.Equal(true, ok)
+	assert.Equal(7, len(bulkErr.Errors))
+	for i, pageErr := range bulkErr.Errors {
+		assert.Equal(4+i, pageErr.Page)
+		var rle *RateLimitError
+		assert.Equal(true, errors.As(pageErr, &rle))
+	}
+
+	// the first page failing is reported as is
+	server.status = map[int]int{1: 500}
+	photos, err = unsplash.Photos.AllPager(ctx, nil, nil).FetchAll(0)
+	assert.Equal(0, len(photos))
+	_, ok = err.(*ErrorResponse)
+	assert.Equal(true, ok)
+}
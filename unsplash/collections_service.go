@@ -24,6 +24,7 @@
 package unsplash
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -36,43 +37,175 @@ type CollectionsService service
 // Note that some fields in collection structs from this result will be missing.
 // Use Collection() method to get all details of the Collection.
 func (cs *CollectionsService) All(opt *ListOpt) (*[]Collection, *Response, error) {
+	return cs.AllWithContext(context.Background(), opt)
+}
+
+// AllWithContext is like All but uses ctx for the request.
+func (cs *CollectionsService) AllWithContext(ctx context.Context, opt *ListOpt) (*[]Collection, *Response, error) {
+	s := (service)(*cs)
+	return s.getCollections(ctx, opt, getEndpoint(collections))
+}
+
+// AllPager returns a CollectionPager over all collections on unsplash.
+// opt sets the first page and the page size.
+func (cs *CollectionsService) AllPager(ctx context.Context, opt *ListOpt, pagerOpt *PagerOpt) *CollectionPager {
 	s := (service)(*cs)
-	return s.getCollections(opt, getEndpoint(collections))
+	return s.collectionPager(ctx, opt, getEndpoint(collections), pagerOpt)
 }
 
 // Featured returns a list of featured collections on unsplash.
 // Note that some fields in collection structs from this result will be missing.
 // Use Collection() method to get all details of the Collection.
 func (cs *CollectionsService) Featured(opt *ListOpt) (*[]Collection, *Response, error) {
+	return cs.FeaturedWithContext(context.Background(), opt)
+}
+
+// FeaturedWithContext is like Featured but uses ctx for the request.
+func (cs *CollectionsService) FeaturedWithContext(ctx context.Context, opt *ListOpt) (*[]Collection, *Response, error) {
 	s := (service)(*cs)
-	return s.getCollections(opt, getEndpoint(collections)+"/featured")
+	return s.getCollections(ctx, opt, getEndpoint(collections)+"/featured")
+}
+
+// FeaturedPager returns a CollectionPager over featured collections.
+func (cs *CollectionsService) FeaturedPager(ctx context.Context, opt *ListOpt, pagerOpt *PagerOpt) *CollectionPager {
+	s := (service)(*cs)
+	return s.collectionPager(ctx, opt, getEndpoint(collections)+"/featured", pagerOpt)
 }
 
 // Curated returns a list of curated collections on unsplash.
 // Note that some fields in collection structs from this result will be missing.
 // Use Collection() method to get all details of the Collection.
 func (cs *CollectionsService) Curated(opt *ListOpt) (*[]Collection, *Response, error) {
+	return cs.CuratedWithContext(context.Background(), opt)
+}
+
+// CuratedWithContext is like Curated but uses ctx for the request.
+func (cs *CollectionsService) CuratedWithContext(ctx context.Context, opt *ListOpt) (*[]Collection, *Response, error) {
 	s := (service)(*cs)
-	return s.getCollections(opt, getEndpoint(collections)+"/curated")
+	return s.getCollections(ctx, opt, getEndpoint(collections)+"/curated")
+}
+
+// CuratedPager returns a CollectionPager over curated collections.
+func (cs *CollectionsService) CuratedPager(ctx context.Context, opt *ListOpt, pagerOpt *PagerOpt) *CollectionPager {
+	s := (service)(*cs)
+	return s.collectionPager(ctx, opt, getEndpoint(collections)+"/curated", pagerOpt)
 }
 
 // Related returns a list of collections related to collections with id.
 func (cs *CollectionsService) Related(id string, opt *ListOpt) (*[]Collection, *Response, error) {
+	return cs.RelatedWithContext(context.Background(), id, opt)
+}
+
+// RelatedWithContext is like Related but uses ctx for the request.
+func (cs *CollectionsService) RelatedWithContext(ctx context.Context, id string, opt *ListOpt) (*[]Collection, *Response, error) {
 	if "" == id {
 		return nil, nil, &IllegalArgumentError{ErrString: "Collection ID cannot be nil"}
 	}
 	s := (service)(*cs)
 	endpoint := fmt.Sprintf("%v/%v/%v", getEndpoint(collections), id, "related")
-	return s.getCollections(opt, endpoint)
+	return s.getCollections(ctx, opt, endpoint)
+}
+
+// RelatedPager returns a CollectionPager over collections related to
+// the collection with id.
+func (cs *CollectionsService) RelatedPager(ctx context.Context, id string, opt *ListOpt, pagerOpt *PagerOpt) *CollectionPager {
+	if "" == id {
+		return &CollectionPager{pager: pager{err: &IllegalArgumentError{ErrString: "Collection ID cannot be nil"}}}
+	}
+	s := (service)(*cs)
+	endpoint := fmt.Sprintf("%v/%v/%v", getEndpoint(collections), id, "related")
+	return s.collectionPager(ctx, opt, endpoint, pagerOpt)
+}
+
+// CollectionPhotosOpt should be used to list photos of a collection
+type CollectionPhotosOpt struct {
+	Page        int         `url:"page"`
+	PerPage     int         `url:"per_page"`
+	Orientation orientation `url:"orientation,omitempty"`
+}
+
+var defaultCollectionPhotosOpt = &CollectionPhotosOpt{
+	Page:    1,
+	PerPage: 10,
+}
+
+// Valid validates the values in a CollectionPhotosOpt
+func (opt *CollectionPhotosOpt) Valid() bool {
+	if opt.Page < 0 || opt.PerPage < 0 {
+		return false
+	}
+	if opt.Page == 0 {
+		opt.Page = 1
+	}
+	if opt.PerPage == 0 {
+		opt.PerPage = 10
+	}
+	return opt.Orientation == "" || opt.Orientation.valid()
+}
+
+// Photos returns a list of photos in the collection with id.
+func (cs *CollectionsService) Photos(id string, opt *CollectionPhotosOpt) (*[]Photo, *Response, error) {
+	return cs.PhotosWithContext(context.Background(), id, opt)
+}
+
+// PhotosWithContext is like Photos but uses ctx for the request.
+func (cs *CollectionsService) PhotosWithContext(ctx context.Context, id string, opt *CollectionPhotosOpt) (*[]Photo, *Response, error) {
+	if "" == id {
+		return nil, nil, &IllegalArgumentError{ErrString: "Collection ID cannot be nil"}
+	}
+	if nil == opt {
+		opt = defaultCollectionPhotosOpt
+	}
+	if !opt.Valid() {
+		return nil, nil, &InvalidListOptError{ErrString: "opt provided is not valid."}
+	}
+	endpoint := fmt.Sprintf("%v/%v/%v", getEndpoint(collections), id, getEndpoint(photos))
+	req, err := cs.client.newRequest(ctx, GET, endpoint, opt, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	resp, err := cs.client.do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	photos := make([]Photo, 0)
+	err = json.Unmarshal(*resp.body, &photos)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &photos, resp, nil
+}
+
+// PhotosPager returns a PhotoPager over photos in the collection with id.
+func (cs *CollectionsService) PhotosPager(ctx context.Context, id string, opt *CollectionPhotosOpt, pagerOpt *PagerOpt) *PhotoPager {
+	if "" == id {
+		return &PhotoPager{pager: pager{err: &IllegalArgumentError{ErrString: "Collection ID cannot be nil"}}}
+	}
+	if nil == opt {
+		opt = defaultCollectionPhotosOpt
+	}
+	photosOpt := *opt
+	p := &PhotoPager{pager: newPager(ctx, photosOpt.Page, pagerOpt)}
+	p.fetch = func(ctx context.Context, page int) (*[]Photo, *Response, error) {
+		pageOpt := photosOpt
+		pageOpt.Page = page
+		return cs.PhotosWithContext(ctx, id, &pageOpt)
+	}
+	return p
 }
 
 // Collection returns a collection with id.
 func (cs *CollectionsService) Collection(id string) (*Collection, *Response, error) {
+	return cs.CollectionWithContext(context.Background(), id)
+}
+
+// CollectionWithContext is like Collection but uses ctx for the request.
+func (cs *CollectionsService) CollectionWithContext(ctx context.Context, id string) (*Collection, *Response, error) {
 	if "" == id {
 		return nil, nil, &IllegalArgumentError{ErrString: "Collection ID cannot be nil"}
 	}
 	endpoint := fmt.Sprintf("%v/%v", getEndpoint(collections), id)
-	req, err := newRequest(GET, endpoint, nil, nil)
+	req, err := cs.client.newRequest(ctx, GET, endpoint, nil, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -98,13 +231,21 @@ type CollectionOpt struct {
 
 //Create creates a new collection on the authenticated  user's profile.
 func (cs *CollectionsService) Create(opt *CollectionOpt) (*Collection, *Response, error) {
+	return cs.CreateWithContext(context.Background(), opt)
+}
+
+// CreateWithContext is like Create but uses ctx for the request.
+func (cs *CollectionsService) CreateWithContext(ctx context.Context, opt *CollectionOpt) (*Collection, *Response, error) {
+	if err := cs.client.requireScope(WriteCollections); err != nil {
+		return nil, nil, err
+	}
 	if nil == opt {
 		return nil, nil, &IllegalArgumentError{ErrString: "Opt cannot be nil"}
 	}
 	if *opt.Title == "" {
 		return nil, nil, &IllegalArgumentError{ErrString: "Need to provide a title for the new collection."}
 	}
-	req, err := newRequest(POST, getEndpoint(collections), opt, nil)
+	req, err := cs.client.newRequest(ctx, POST, getEndpoint(collections), opt, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -125,6 +266,14 @@ func (cs *CollectionsService) Create(opt *CollectionOpt) (*Collection, *Response
 
 //Update updates an existing collection on the authenticated  user's profile.
 func (cs *CollectionsService) Update(collectionID int, opt *CollectionOpt) (*Collection, *Response, error) {
+	return cs.UpdateWithContext(context.Background(), collectionID, opt)
+}
+
+// UpdateWithContext is like Update but uses ctx for the request.
+func (cs *CollectionsService) UpdateWithContext(ctx context.Context, collectionID int, opt *CollectionOpt) (*Collection, *Response, error) {
+	if err := cs.client.requireScope(WriteCollections); err != nil {
+		return nil, nil, err
+	}
 	if nil == opt {
 		return nil, nil, &IllegalArgumentError{ErrString: "Opt cannot be nil"}
 	}
@@ -132,7 +281,7 @@ func (cs *CollectionsService) Update(collectionID int, opt *CollectionOpt) (*Col
 		return nil, nil, &IllegalArgumentError{ErrString: "collectionID cannot be nil."}
 	}
 	endpoint := fmt.Sprintf("%v/%v", getEndpoint(collections), collectionID)
-	req, err := newRequest(PUT, endpoint, opt, nil)
+	req, err := cs.client.newRequest(ctx, PUT, endpoint, opt, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -150,11 +299,19 @@ func (cs *CollectionsService) Update(collectionID int, opt *CollectionOpt) (*Col
 
 //Delete deletes a collection on the authenticated user's profile.
 func (cs *CollectionsService) Delete(collectionID int) (*Response, error) {
+	return cs.DeleteWithContext(context.Background(), collectionID)
+}
+
+// DeleteWithContext is like Delete but uses ctx for the request.
+func (cs *CollectionsService) DeleteWithContext(ctx context.Context, collectionID int) (*Response, error) {
+	if err := cs.client.requireScope(WriteCollections); err != nil {
+		return nil, err
+	}
 	if collectionID == 0 {
 		return nil, &IllegalArgumentError{ErrString: "CollectionID cannot be empty or zero."}
 	}
 	endpoint := fmt.Sprintf("%v/%v", getEndpoint(collections), collectionID)
-	req, err := newRequest(DELETE, endpoint, nil, nil)
+	req, err := cs.client.newRequest(ctx, DELETE, endpoint, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -174,6 +331,14 @@ type addPhoto struct {
 
 //AddPhoto adds a photo to a collection owned by an authenticated user.
 func (cs *CollectionsService) AddPhoto(collectionID int, photoID string) (*Response, error) {
+	return cs.AddPhotoWithContext(context.Background(), collectionID, photoID)
+}
+
+// AddPhotoWithContext is like AddPhoto but uses ctx for the request.
+func (cs *CollectionsService) AddPhotoWithContext(ctx context.Context, collectionID int, photoID string) (*Response, error) {
+	if err := cs.client.requireScope(WriteCollections); err != nil {
+		return nil, err
+	}
 	if collectionID == 0 {
 		return nil, &IllegalArgumentError{ErrString: "CollectionID cannot be empty or zero."}
 	}
@@ -182,7 +347,7 @@ func (cs *CollectionsService) AddPhoto(collectionID int, photoID string) (*Respo
 	}
 	opt := &addPhoto{photoID}
 	endpoint := fmt.Sprintf("%v/%v/add", getEndpoint(collections), collectionID)
-	req, err := newRequest(POST, endpoint, opt, nil)
+	req, err := cs.client.newRequest(ctx, POST, endpoint, opt, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -198,6 +363,14 @@ func (cs *CollectionsService) AddPhoto(collectionID int, photoID string) (*Respo
 
 //RemovePhoto removes a photo from a collection owned by an authenticated user.
 func (cs *CollectionsService) RemovePhoto(collectionID int, photoID string) (*Response, error) {
+	return cs.RemovePhotoWithContext(context.Background(), collectionID, photoID)
+}
+
+// RemovePhotoWithContext is like RemovePhoto but uses ctx for the request.
+func (cs *CollectionsService) RemovePhotoWithContext(ctx context.Context, collectionID int, photoID string) (*Response, error) {
+	if err := cs.client.requireScope(WriteCollections); err != nil {
+		return nil, err
+	}
 	if collectionID == 0 {
 		return nil, &IllegalArgumentError{ErrString: "CollectionID cannot be empty or zero."}
 	}
@@ -206,7 +379,7 @@ func (cs *CollectionsService) RemovePhoto(collectionID int, photoID string) (*Re
 	}
 	opt := &addPhoto{photoID}
 	endpoint := fmt.Sprintf("%v/%v/remove", getEndpoint(collections), collectionID)
-	req, err := newRequest(DELETE, endpoint, opt, nil)
+	req, err := cs.client.newRequest(ctx, DELETE, endpoint, opt, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -24,9 +24,13 @@
 package unsplash
 
 import (
+	"context"
+	"fmt"
 	"io/ioutil"
 	"log"
 	"math/rand"
+	"net/http"
+	"net/http/httptest"
 	"strconv"
 	"testing"
 
@@ -411,3 +415,54 @@ func TestRemovePhotoRogue(T *testing.T) {
 	assert.NotNil(err)
 	log.Println(err)
 }
+
+func TestCollectionPhotos(T *testing.T) {
+	assert := assert.New(T)
+	log.SetOutput(ioutil.Discard)
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/collections/206/photos" {
+			w.WriteHeader(404)
+			return
+		}
+		queries = append(queries, r.URL.RawQuery)
+		fmt.Fprint(w, `[{"id": "gopherPhoto1"}, {"id": "gopherPhoto2"}]`)
+	}))
+	defer server.Close()
+	unsplash := New(nil, WithBaseURL(server.URL))
+
+	photos, resp, err := unsplash.Collections.Photos("206", nil)
+	assert.Nil(err)
+	assert.NotNil(resp)
+	assert.Equal(2, len(*photos))
+	assert.Equal("gopherPhoto1", *(*photos)[0].ID)
+	assert.Equal("page=1&per_page=10", queries[0])
+
+	opt := &CollectionPhotosOpt{Page: 2, PerPage: 30, Orientation: Squarish}
+	_, _, err = unsplash.Collections.Photos("206", opt)
+	assert.Nil(err)
+	assert.Equal("orientation=squarish&page=2&per_page=30", queries[1])
+
+	_, _, err = unsplash.Collections.Photos("206", &CollectionPhotosOpt{Orientation: "round"})
+	assert.NotNil(err)
+	_, ok := err.(*InvalidListOptError)
+	assert.Equal(true, ok)
+
+	_, _, err = unsplash.Collections.Photos("", nil)
+	assert.NotNil(err)
+	_, ok = err.(*IllegalArgumentError)
+	assert.Equal(true, ok)
+
+	_, _, err = unsplash.Collections.Photos("42", nil)
+	assert.NotNil(err)
+	_, ok = err.(*NotFoundError)
+	assert.Equal(true, ok)
+
+	pager := unsplash.Collections.PhotosPager(context.Background(), "206", nil, &PagerOpt{MaxItems: 3})
+	count := 0
+	for pager.Next() {
+		count++
+	}
+	assert.Nil(pager.Err())
+	assert.Equal(2, count)
+}
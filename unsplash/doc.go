@@ -41,6 +41,15 @@ should not be shared between users.
 Note that if you're just using actions that require the public permission scope,
 only the AppID is required.
 
+NewAuthenticator implements the OAuth2 authorization code flow and
+returns a client acting on behalf of the user.
+
+	auth, err := unsplash.NewAuthenticator(accessKey, secretKey, redirectURL,
+	  unsplash.Scopes{unsplash.Public, unsplash.WriteLikes})
+	url := auth.AuthCodeURL(state)
+	//redirect the user to url, then in the callback:
+	client, token, err := auth.ExchangeClient(ctx, code)
+
 
 Creating an instance
 
@@ -56,6 +65,28 @@ The http.Client supplied will be used to make requests to the API.
 	// requests can be now made to the API
 	randomPhoto, _ , err := unsplash.RandomPhoto(nil)
 
+Options can be passed to New() to configure a client, for example
+to point it at a different host or to send a custom User-Agent.
+Each client keeps its own configuration.
+
+	staging := unsplash.New(client,
+	  unsplash.WithBaseURL("https://unsplash-proxy.example.com/"),
+	  unsplash.WithUserAgent("my-app/1.0"),
+	)
+
+Transient failures such as network errors or 502 responses
+can be retried with exponential backoff by supplying a RetryPolicy.
+
+	u := unsplash.New(client, unsplash.WithRetryPolicy(unsplash.DefaultRetryPolicy()))
+
+A RateLimiter keeps track of the hourly quota reported by the API and holds
+back requests before it runs out, either failing fast with a RateLimitError or
+blocking until the quota resets. State() reports the quota left.
+
+	limiter := &unsplash.RateLimiter{Reserve: 10}
+	u := unsplash.New(client, unsplash.WithRateLimiter(limiter))
+	fmt.Println(limiter.State().Remaining)
+
 Error handling
 
 All API calls return an error as second or third return object.
@@ -67,6 +98,30 @@ Further, go-unsplash has errors defined as types for better error handling.
 	  //handle error
 	}
 
+Errors returned by the API are reported as an *ErrorResponse, which carries
+the HTTP status code, the error messages sent by Unsplash, the request URL and
+rate limit information. AuthorizationError, NotFoundError and RateLimitError
+wrap an ErrorResponse, so it can always be retrieved with errors.As.
+
+	var errResp *unsplash.ErrorResponse
+	if errors.As(err, &errResp) {
+	  fmt.Println(errResp.StatusCode, errResp.Errors)
+	}
+
+Context
+
+Every API call has a WithContext variant that accepts a context.Context
+as its first argument. The context is attached to the underlying HTTP request,
+so deadlines and cancellation propagate to the API call.
+If the context is done, the context's error is returned as is.
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	photos, _, err := unsplash.Photos.RandomWithContext(ctx, nil)
+	if err == context.DeadlineExceeded {
+	  //took too long
+	}
+
 Pagination
 
 Pagination is supported by supplying a page
@@ -90,5 +145,24 @@ The NextPage field in Response can be used to  get the next page number.
 		searchOpt.Page = resp.NextPage
 	}
 
+Alternatively, every list and search endpoint has a Pager variant which
+requests pages as needed and yields the items one at a time.
+PagerOpt can limit the number of items or pages.
+
+	pager := unsplash.Search.PhotosPager(ctx, searchOpt, &unsplash.PagerOpt{MaxItems: 100})
+	for pager.Next() {
+		photo := pager.Photo()
+		//process photo
+	}
+	if err := pager.Err(); err != nil {
+		//handle error
+	}
+
+FetchAll returns everything a pager has left, requesting the pages after the
+first one concurrently. If some pages fail, the others are still returned
+along with a *BulkError.
+
+	photos, err := unsplash.Users.PhotosPager(ctx, "gopher", nil, nil).FetchAll(4)
+
 */
 package unsplash
@@ -0,0 +1,527 @@
+// Copyright (c) 2017 Hardik Bagdi <hbagdi1@binghamton.edu>
+//
+// MIT License
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package unsplash
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"hash/fnv"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/google/go-querystring/query"
+)
+
+// DownloadSize selects which version of a photo a Downloader gets.
+type DownloadSize string
+
+// The sizes of the URLs returned by the API for every photo.
+const (
+	SizeRaw     DownloadSize = "raw"
+	SizeFull    DownloadSize = "full"
+	SizeRegular DownloadSize = "regular"
+	SizeSmall   DownloadSize = "small"
+	SizeThumb   DownloadSize = "thumb"
+)
+
+var downloadSizes = []DownloadSize{SizeRaw, SizeFull, SizeRegular, SizeSmall, SizeThumb}
+
+// partSuffix is appended to the name of files being downloaded.
+const partSuffix = ".part"
+
+// DownloadOpt configures a Downloader.
+type DownloadOpt struct {
+	// Dir is the directory photos are written to,
+	// the working directory if empty. It is created if needed.
+	Dir string
+	// Size of the photos, SizeFull if empty. Ignored if Image is set.
+	Size DownloadSize
+	// Image resizes the raw photos with imgix instead of picking one of
+	// the sizes.
+	Image *ImageOpt
+	// Workers is the number of concurrent downloads, 4 if 0.
+	Workers int
+	// Overwrite downloads photos again even if their file exists.
+	// Existing files are skipped by default.
+	Overwrite bool
+	// WriteChecksums writes the SHA-256 of every photo next to it,
+	// to a file with a .sha256 suffix in the format of sha256sum.
+	WriteChecksums bool
+	// Progress, if set, is called as downloads progress.
+	// It may be called from several goroutines at once.
+	Progress func(DownloadProgress)
+}
+
+// Valid validates a DownloadOpt and sets the default size.
+func (opt *DownloadOpt) Valid() bool {
+	if opt.Workers < 0 {
+		return false
+	}
+	if opt.Image != nil {
+		return opt.Image.Valid()
+	}
+	if opt.Size == "" {
+		opt.Size = SizeFull
+	}
+	for _, size := range downloadSizes {
+		if opt.Size == size {
+			return true
+		}
+	}
+	return false
+}
+
+// DownloadProgress reports the progress of a download.
+type DownloadProgress struct {
+	PhotoID string
+	// Path is the file the photo is written to.
+	Path string
+	// Written is the number of bytes written so far, including the ones
+	// written before a resumed download was interrupted.
+	Written int64
+	// Total is the size of the photo, -1 if unknown.
+	Total int64
+	// Done is set by the last report of a download.
+	Done bool
+	// Err is the error the download failed with, if any.
+	Err error
+}
+
+// DownloadResult is the outcome of downloading a photo.
+type DownloadResult struct {
+	PhotoID string
+	// Path is the file the photo was written to.
+	Path string
+	// Size is the size of the file in bytes.
+	Size int64
+	// SHA256 is the hex encoded SHA-256 of the file.
+	SHA256 string
+	// Resumed is set if a previously interrupted download was resumed.
+	Resumed bool
+	// Skipped is set if the file already existed.
+	Skipped bool
+	// Err is the error the download failed with, if any.
+	Err error
+}
+
+// Downloader downloads photos to files, concurrently.
+//
+// Files are named after the photo ID and size, so downloading a photo
+// again finds the existing file. They are written to a .part file first
+// and renamed once complete, so they never contain partial photos.
+// An interrupted download is resumed from its .part file, using an
+// HTTP range request.
+//
+// Every download is tracked with PhotosService.TrackDownload, as required
+// by the API guidelines. Image files are requested without credentials,
+// see WithImageClient.
+type Downloader struct {
+	client *Unsplash
+	opt    DownloadOpt
+}
+
+// NewDownloader returns a Downloader using client for the API requests
+// and the downloads. opt can be nil to use the defaults.
+func NewDownloader(client *Unsplash, opt *DownloadOpt) (*Downloader, error) {
+	if client == nil {
+		return nil, &IllegalArgumentError{ErrString: "Client cannot be nil"}
+	}
+	d := &Downloader{client: client}
+	if opt != nil {
+		d.opt = *opt
+	}
+	if !d.opt.Valid() {
+		return nil, &InvalidDownloadOptError{ErrString: "opt provided is not valid."}
+	}
+	if d.opt.Workers == 0 {
+		d.opt.Workers = defaultWorkers
+	}
+	return d, nil
+}
+
+// Download downloads photos and returns the results in the same order.
+// If some of the downloads fail, a *DownloadError is returned as well.
+// A photo given more than once is downloaded once and all of its
+// results are the same.
+func (d *Downloader) Download(photos []Photo) ([]DownloadResult, error) {
+	return d.DownloadWithContext(context.Background(), photos)
+}
+
+// DownloadWithContext is like Download but uses ctx for the requests.
+func (d *Downloader) DownloadWithContext(ctx context.Context, photos []Photo) ([]DownloadResult, error) {
+	return d.run(ctx, len(photos), func(ctx context.Context, i int) (*Photo, error) {
+		return &photos[i], nil
+	}, func(i int) string {
+		return stringValue(photos[i].ID)
+	})
+}
+
+// DownloadIDs is like Download but takes photo IDs, which are looked up
+// with PhotosService.Photo first.
+func (d *Downloader) DownloadIDs(ids []string) ([]DownloadResult, error) {
+	return d.DownloadIDsWithContext(context.Background(), ids)
+}
+
+// DownloadIDsWithContext is like DownloadIDs but uses ctx for the requests.
+func (d *Downloader) DownloadIDsWithContext(ctx context.Context, ids []string) ([]DownloadResult, error) {
+	return d.run(ctx, len(ids), func(ctx context.Context, i int) (*Photo, error) {
+		if ids[i] == "" {
+			return nil, &IllegalArgumentError{ErrString: "Photo ID cannot be empty"}
+		}
+		photo, _, err := d.client.Photos.PhotoWithContext(ctx, ids[i], nil)
+		return photo, err
+	}, func(i int) string {
+		return ids[i]
+	})
+}
+
+// Filename returns the name of the file photo is downloaded to.
+// It depends only on the ID of the photo and the size downloaded.
+func (d *Downloader) Filename(photo *Photo) string {
+	id := strings.Map(func(r rune) rune {
+		if r == '-' || r == '_' || r >= '0' && r <= '9' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' {
+			return r
+		}
+		return '_'
+	}, stringValue(photo.ID))
+	if d.opt.Image == nil {
+		return id + "-" + string(d.opt.Size) + ".jpg"
+	}
+	// name custom sizes after their parameters
+	params, _ := query.Values(d.opt.Image)
+	h := fnv.New32a()
+	h.Write([]byte(params.Encode()))
+	ext := "jpg"
+	switch d.opt.Image.Format {
+	case "", FormatJPG, FormatPJPG:
+	default:
+		ext = string(d.opt.Image.Format)
+	}
+	return fmt.Sprintf("%v-%08x.%v", id, h.Sum32(), ext)
+}
+
+// run downloads n photos with a pool of workers.
+// photo returns the i-th photo and id its ID, which is used for reporting
+// errors and to find photos saved to the same file.
+func (d *Downloader) run(ctx context.Context, n int, photo func(ctx context.Context, i int) (*Photo, error), id func(i int) string) ([]DownloadResult, error) {
+	if ctx == nil {
+		return nil, &IllegalArgumentError{ErrString: "Context can't be nil."}
+	}
+	if d.opt.Dir != "" {
+		if err := os.MkdirAll(d.opt.Dir, 0755); err != nil {
+			return nil, err
+		}
+	}
+	// photos saved to the same file are downloaded once and
+	// share the result, so that workers don't race on the file
+	first := make([]int, n)
+	seen := map[string]int{}
+	for i := 0; i < n; i++ {
+		first[i] = i
+		photoID := id(i)
+		if photoID == "" {
+			continue
+		}
+		name := d.Filename(&Photo{ID: &photoID})
+		if j, ok := seen[name]; ok {
+			first[i] = j
+		} else {
+			seen[name] = i
+		}
+	}
+	results := make([]DownloadResult, n)
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < d.opt.Workers && w < n; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				p, err := photo(ctx, i)
+				if err == nil {
+					results[i] = d.download(ctx, p)
+				} else {
+					results[i] = DownloadResult{PhotoID: id(i), Err: err}
+				}
+			}
+		}()
+	}
+	for i := 0; i < n; i++ {
+		if first[i] == i {
+			indexes <- i
+		}
+	}
+	close(indexes)
+	wg.Wait()
+	for i := 0; i < n; i++ {
+		results[i] = results[first[i]]
+	}
+
+	var errs []*PhotoDownloadError
+	for _, result := range results {
+		if result.Err != nil {
+			errs = append(errs, &PhotoDownloadError{PhotoID: result.PhotoID, Err: result.Err})
+		}
+	}
+	if len(errs) != 0 {
+		return results, &DownloadError{Errors: errs}
+	}
+	return results, nil
+}
+
+// download downloads a single photo and reports the final progress.
+func (d *Downloader) download(ctx context.Context, photo *Photo) DownloadResult {
+	result := DownloadResult{PhotoID: stringValue(photo.ID)}
+	if photo.ID == nil {
+		result.Err = &IllegalArgumentError{ErrString: "Photo has no ID"}
+		return result
+	}
+	result.Path = filepath.Join(d.opt.Dir, d.Filename(photo))
+	total := int64(-1)
+	result.Err = d.fetch(ctx, photo, &result, &total)
+	d.report(DownloadProgress{
+		PhotoID: result.PhotoID,
+		Path:    result.Path,
+		Written: result.Size,
+		Total:   total,
+		Done:    true,
+		Err:     result.Err,
+	})
+	return result
+}
+
+func (d *Downloader) fetch(ctx context.Context, photo *Photo, result *DownloadResult, total *int64) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if !d.opt.Overwrite {
+		if f, err := os.Open(result.Path); err == nil {
+			defer f.Close()
+			h := sha256.New()
+			n, err := io.Copy(h, f)
+			if err != nil {
+				return err
+			}
+			result.Skipped, result.Size, *total = true, n, n
+			result.SHA256 = hex.EncodeToString(h.Sum(nil))
+			return d.writeChecksum(result)
+		}
+	}
+	src, err := d.source(photo)
+	if err != nil {
+		return err
+	}
+	part := result.Path + partSuffix
+	f, err := os.OpenFile(part, os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if f != nil {
+			f.Close()
+		}
+	}()
+	// hash what an interrupted download left
+	h := sha256.New()
+	offset, err := io.Copy(h, f)
+	if err != nil {
+		return err
+	}
+	if offset == 0 {
+		// the photo wasn't tracked yet
+		if _, _, err = d.client.Photos.TrackDownloadWithContext(ctx, photo); err != nil {
+			return err
+		}
+	}
+	resp, err := d.get(ctx, src, offset)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	resumed := resp.StatusCode == http.StatusPartialContent && offset > 0 &&
+		strings.HasPrefix(resp.Header.Get("Content-Range"), "bytes "+strconv.FormatInt(offset, 10)+"-")
+	switch {
+	case resumed:
+		result.Resumed = true
+	case offset > 0 && (resp.StatusCode == http.StatusOK ||
+		resp.StatusCode == http.StatusPartialContent ||
+		resp.StatusCode == http.StatusRequestedRangeNotSatisfiable):
+		// the server ignored the range or the partial file is bogus,
+		// start over
+		resp.Body.Close()
+		if resp, err = d.get(ctx, src, 0); err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return downloadErrorResponse(resp)
+		}
+		if err = f.Truncate(0); err != nil {
+			return err
+		}
+		if _, err = f.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+		offset = 0
+		h.Reset()
+	case resp.StatusCode != http.StatusOK:
+		return downloadErrorResponse(resp)
+	}
+	if resp.ContentLength >= 0 {
+		*total = offset + resp.ContentLength
+	}
+	w := &progressWriter{d: d, result: result, total: *total, written: offset, hash: h}
+	result.Size = offset
+	n, err := io.Copy(io.MultiWriter(f, w), resp.Body)
+	result.Size = offset + n
+	if err != nil {
+		return err
+	}
+	if *total >= 0 && result.Size != *total {
+		return fmt.Errorf("download of photo %v is incomplete: got %d of %d bytes", result.PhotoID, result.Size, *total)
+	}
+	if err = f.Sync(); err != nil {
+		return err
+	}
+	err = f.Close()
+	f = nil
+	if err != nil {
+		return err
+	}
+	if err = os.Rename(part, result.Path); err != nil {
+		return err
+	}
+	*total = result.Size
+	result.SHA256 = hex.EncodeToString(h.Sum(nil))
+	return d.writeChecksum(result)
+}
+
+// source returns the URL of the image to download.
+func (d *Downloader) source(photo *Photo) (*URL, error) {
+	if d.opt.Image != nil {
+		return photo.ImageURL(d.opt.Image)
+	}
+	var u *URL
+	if photo.Urls != nil {
+		switch d.opt.Size {
+		case SizeRaw:
+			u = photo.Urls.Raw
+		case SizeFull:
+			u = photo.Urls.Full
+		case SizeRegular:
+			u = photo.Urls.Regular
+		case SizeSmall:
+			u = photo.Urls.Small
+		case SizeThumb:
+			u = photo.Urls.Thumb
+		}
+	}
+	if u == nil || u.URL == nil {
+		return nil, &IllegalArgumentError{ErrString: fmt.Sprintf("Photo has no %v URL", d.opt.Size)}
+	}
+	return u, nil
+}
+
+// get requests src, from offset on if it isn't 0.
+// Like PhotosService.Download, it sends no credentials to the image host.
+func (d *Downloader) get(ctx context.Context, src *URL, offset int64) (*http.Response, error) {
+	var header http.Header
+	if offset > 0 {
+		header = http.Header{"Range": []string{fmt.Sprintf("bytes=%d-", offset)}}
+	}
+	return d.client.getImage(ctx, src, header)
+}
+
+// writeChecksum writes the checksum file of result, if enabled.
+func (d *Downloader) writeChecksum(result *DownloadResult) error {
+	if !d.opt.WriteChecksums {
+		return nil
+	}
+	line := result.SHA256 + "  " + filepath.Base(result.Path) + "\n"
+	path := result.Path + ".sha256"
+	tmp, err := ioutil.TempFile(filepath.Dir(path), "."+filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err = tmp.WriteString(line); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+func (d *Downloader) report(p DownloadProgress) {
+	if d.opt.Progress != nil {
+		d.opt.Progress(p)
+	}
+}
+
+// progressWriter hashes the bytes downloaded and reports the progress.
+type progressWriter struct {
+	d       *Downloader
+	result  *DownloadResult
+	total   int64
+	written int64
+	hash    hash.Hash
+}
+
+func (w *progressWriter) Write(b []byte) (int, error) {
+	w.hash.Write(b)
+	w.written += int64(len(b))
+	w.d.report(DownloadProgress{
+		PhotoID: w.result.PhotoID,
+		Path:    w.result.Path,
+		Written: w.written,
+		Total:   w.total,
+	})
+	return len(b), nil
+}
+
+func downloadErrorResponse(resp *http.Response) error {
+	return &ErrorResponse{
+		Response:   resp,
+		StatusCode: resp.StatusCode,
+		RequestURL: resp.Request.URL.String(),
+	}
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
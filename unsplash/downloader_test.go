@@ -0,0 +1,281 @@
+// Copyright (c) 2017 Hardik Bagdi <hbagdi1@binghamton.edu>
+//
+// MIT License
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package unsplash
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hbagdi/go-unsplash/unsplash/unsplashtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDownloader(T *testing.T) {
+	assert := assert.New(T)
+	server := unsplashtest.NewServer()
+	defer server.Close()
+	dir, err := ioutil.TempDir("", "downloads")
+	assert.Nil(err)
+	defer os.RemoveAll(dir)
+	client := NewWithClientID(nil, "key", WithBaseURL(server.URL))
+
+	var mu sync.Mutex
+	done := map[string]DownloadProgress{}
+	downloader, err := NewDownloader(client, &DownloadOpt{
+		Dir:            dir,
+		Size:           SizeRegular,
+		Workers:        2,
+		WriteChecksums: true,
+		Progress: func(p DownloadProgress) {
+			if p.Done {
+				mu.Lock()
+				done[p.PhotoID] = p
+				mu.Unlock()
+			}
+		},
+	})
+	assert.Nil(err)
+	ids := []string{"photo-01", "photo-02", "photo-03", "photo-04", "photo-05"}
+	results, err := downloader.DownloadIDs(ids)
+	assert.Nil(err)
+	assert.Equal(5, len(results))
+	for i, result := range results {
+		data := unsplashtest.ImageData(ids[i])
+		sum := sha256.Sum256(data)
+		assert.Equal(ids[i], result.PhotoID)
+		assert.Equal(filepath.Join(dir, ids[i]+"-regular.jpg"), result.Path)
+		assert.Equal(int64(len(data)), result.Size)
+		assert.Equal(hex.EncodeToString(sum[:]), result.SHA256)
+		assert.Equal(false, result.Skipped)
+		written, _ := ioutil.ReadFile(result.Path)
+		assert.Equal(data, written)
+		checksum, _ := ioutil.ReadFile(result.Path + ".sha256")
+		assert.Equal(result.SHA256+"  "+ids[i]+"-regular.jpg\n", string(checksum))
+		assert.Equal(int64(len(data)), done[ids[i]].Total)
+		photo, _ := server.Photo(ids[i])
+		assert.Equal(1, photo.Downloads-seededDownloads(T, ids[i]))
+	}
+	files, _ := filepath.Glob(filepath.Join(dir, "*.part"))
+	assert.Equal(0, len(files))
+
+	// existing files are neither downloaded nor tracked again
+	results, err = downloader.DownloadIDs(ids[:1])
+	assert.Nil(err)
+	assert.Equal(true, results[0].Skipped)
+	assert.Equal(hex.EncodeToString(sha(unsplashtest.ImageData("photo-01"))), results[0].SHA256)
+	photo, _ := server.Photo("photo-01")
+	assert.Equal(1, photo.Downloads-seededDownloads(T, "photo-01"))
+
+	// errors are reported per photo
+	results, err = downloader.DownloadIDs([]string{"photo-01", "missing"})
+	assert.NotNil(err)
+	downloadErr, ok := err.(*DownloadError)
+	assert.Equal(true, ok)
+	assert.Equal(1, len(downloadErr.Errors))
+	assert.Equal("missing", downloadErr.Errors[0].PhotoID)
+	_, ok = results[1].Err.(*NotFoundError)
+	assert.Equal(true, ok)
+	assert.Nil(results[0].Err)
+	var nfe *NotFoundError
+	assert.Equal(true, errors.As(err, &nfe))
+	var photoErr *PhotoDownloadError
+	assert.Equal(true, errors.As(err, &photoErr))
+	assert.Equal("missing", photoErr.PhotoID)
+	assert.Equal(true, downloadErr.Is(&ErrorResponse{StatusCode: 404}))
+	assert.Equal(false, downloadErr.Is(&ErrorResponse{StatusCode: 500}))
+}
+
+func TestDownloaderResume(T *testing.T) {
+	assert := assert.New(T)
+	server := unsplashtest.NewServer()
+	defer server.Close()
+	dir, err := ioutil.TempDir("", "downloads")
+	assert.Nil(err)
+	defer os.RemoveAll(dir)
+	client := NewWithClientID(nil, "key", WithBaseURL(server.URL))
+	photo, _, err := client.Photos.Photo("photo-07", nil)
+	assert.Nil(err)
+	data := unsplashtest.ImageData("photo-07")
+
+	downloader, err := NewDownloader(client, &DownloadOpt{Dir: dir, Size: SizeRaw})
+	assert.Nil(err)
+	path := filepath.Join(dir, downloader.Filename(photo))
+	assert.Nil(ioutil.WriteFile(path+".part", data[:1000], 0644))
+	results, err := downloader.Download([]Photo{*photo})
+	assert.Nil(err)
+	assert.Equal(true, results[0].Resumed)
+	assert.Equal(hex.EncodeToString(sha(data)), results[0].SHA256)
+	written, _ := ioutil.ReadFile(path)
+	assert.Equal(data, written)
+	// a resumed download was tracked when it started
+	tracked, _ := server.Photo("photo-07")
+	assert.Equal(0, tracked.Downloads-seededDownloads(T, "photo-07"))
+
+	// a partial file larger than the photo is discarded
+	assert.Nil(os.Remove(path))
+	assert.Nil(ioutil.WriteFile(path+".part", make([]byte, len(data)+10), 0644))
+	results, err = downloader.Download([]Photo{*photo})
+	assert.Nil(err)
+	assert.Equal(false, results[0].Resumed)
+	written, _ = ioutil.ReadFile(path)
+	assert.Equal(data, written)
+
+	// Overwrite downloads existing files again
+	downloader, _ = NewDownloader(client, &DownloadOpt{Dir: dir, Size: SizeRaw, Overwrite: true})
+	results, err = downloader.Download([]Photo{*photo})
+	assert.Nil(err)
+	assert.Equal(false, results[0].Skipped)
+}
+
+func TestDownloaderDuplicates(T *testing.T) {
+	assert := assert.New(T)
+	server := unsplashtest.NewServer()
+	defer server.Close()
+	dir, err := ioutil.TempDir("", "downloads")
+	assert.Nil(err)
+	defer os.RemoveAll(dir)
+	client := NewWithClientID(nil, "key", WithBaseURL(server.URL))
+
+	downloader, err := NewDownloader(client, &DownloadOpt{Dir: dir, Workers: 4})
+	assert.Nil(err)
+	ids := []string{"photo-01", "photo-02", "photo-01", "photo-01", "missing", "missing"}
+	results, err := downloader.DownloadIDs(ids)
+	downloadErr, ok := err.(*DownloadError)
+	assert.Equal(true, ok)
+	assert.Equal(2, len(downloadErr.Errors))
+	assert.Equal(len(ids), len(results))
+	for _, i := range []int{2, 3} {
+		assert.Nil(results[i].Err)
+		assert.Equal(results[0], results[i])
+	}
+	data := unsplashtest.ImageData("photo-01")
+	written, _ := ioutil.ReadFile(results[0].Path)
+	assert.Equal(data, written)
+	photo, _ := server.Photo("photo-01")
+	assert.Equal(1, photo.Downloads-seededDownloads(T, "photo-01"))
+	files, _ := filepath.Glob(filepath.Join(dir, "*.part"))
+	assert.Equal(0, len(files))
+
+	// the same applies to photos
+	p, _, err := client.Photos.Photo("photo-03", nil)
+	assert.Nil(err)
+	results, err = downloader.Download([]Photo{*p, *p})
+	assert.Nil(err)
+	assert.Equal(false, results[0].Skipped)
+	assert.Equal(results[0], results[1])
+}
+
+// credentialTransport adds a bearer token to every request, like an OAuth2
+// client does, and records the paths it was sent to.
+type credentialTransport struct {
+	mu    sync.Mutex
+	paths []string
+}
+
+func (t *credentialTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	t.mu.Lock()
+	t.paths = append(t.paths, r.URL.Path)
+	t.mu.Unlock()
+	r = r.Clone(r.Context())
+	r.Header.Set("Authorization", "Bearer secret")
+	return http.DefaultTransport.RoundTrip(r)
+}
+
+func TestDownloaderCredentials(T *testing.T) {
+	assert := assert.New(T)
+	server := unsplashtest.NewServer()
+	defer server.Close()
+	dir, err := ioutil.TempDir("", "downloads")
+	assert.Nil(err)
+	defer os.RemoveAll(dir)
+	transport := new(credentialTransport)
+	client := New(&http.Client{Transport: transport}, WithBaseURL(server.URL))
+
+	downloader, err := NewDownloader(client, &DownloadOpt{Dir: dir, Workers: 2})
+	assert.Nil(err)
+	_, err = downloader.DownloadIDs([]string{"photo-01", "photo-02"})
+	assert.Nil(err)
+	assert.NotEqual(0, len(transport.paths))
+	for _, path := range transport.paths {
+		assert.Equal(false, strings.HasPrefix(path, "/images/"), path)
+	}
+}
+
+func TestDownloaderOpt(T *testing.T) {
+	assert := assert.New(T)
+	client := New(nil)
+	_, err := NewDownloader(nil, nil)
+	_, ok := err.(*IllegalArgumentError)
+	assert.Equal(true, ok)
+	for _, opt := range []*DownloadOpt{
+		{Workers: -1},
+		{Size: "huge"},
+		{Image: &ImageOpt{Quality: 101}},
+	} {
+		_, err = NewDownloader(client, opt)
+		_, ok = err.(*InvalidDownloadOptError)
+		assert.Equal(true, ok)
+	}
+
+	id := "a/b"
+	photo := &Photo{ID: &id}
+	downloader, err := NewDownloader(client, nil)
+	assert.Nil(err)
+	assert.Equal("a_b-full.jpg", downloader.Filename(photo))
+	small, _ := NewDownloader(client, &DownloadOpt{Image: &ImageOpt{Width: 100, Format: FormatWebP}})
+	large, _ := NewDownloader(client, &DownloadOpt{Image: &ImageOpt{Width: 200, Format: FormatWebP}})
+	assert.Equal(true, strings.HasSuffix(small.Filename(photo), ".webp"))
+	assert.NotEqual(small.Filename(photo), large.Filename(photo))
+	again, _ := NewDownloader(client, &DownloadOpt{Image: &ImageOpt{Width: 100, Format: FormatWebP}})
+	assert.Equal(small.Filename(photo), again.Filename(photo))
+
+	results, err := downloader.Download([]Photo{{ID: &id}})
+	assert.NotNil(err)
+	_, ok = results[0].Err.(*IllegalArgumentError)
+	assert.Equal(true, ok)
+}
+
+func sha(data []byte) []byte {
+	sum := sha256.Sum256(data)
+	return sum[:]
+}
+
+// seededDownloads returns the download count of a photo in a fresh server.
+func seededDownloads(T *testing.T, id string) int {
+	server := unsplashtest.NewServer()
+	defer server.Close()
+	photo, ok := server.Photo(id)
+	if !ok {
+		T.Fatalf("no photo %v", id)
+	}
+	return photo.Downloads
+}
@@ -43,6 +43,7 @@ const (
 	usersEndpoint             = "users"
 	photosEndpoint            = "photos"
 	collectionsEndpoint       = "collections"
+	topicsEndpoint            = "topics"
 	searchEndpoint            = "search"
 	searchUserEndpoint        = searchEndpoint + "/" + usersEndpoint
 	searchPhotosEndpoint      = searchEndpoint + "/" + photosEndpoint
@@ -51,6 +52,8 @@ const (
 
 var apiBaseURL = "https://api.unsplash.com/"
 
+const defaultAcceptVersion = "v1"
+
 type endpoint int
 
 const (
@@ -61,6 +64,7 @@ const (
 	users
 	photos
 	collections
+	topics
 	searchUsers
 	searchPhotos
 	searchCollections
@@ -77,6 +81,7 @@ func init() {
 	mapURL[users] = usersEndpoint
 	mapURL[photos] = photosEndpoint
 	mapURL[collections] = collectionsEndpoint
+	mapURL[topics] = topicsEndpoint
 	mapURL[searchUsers] = searchUserEndpoint
 	mapURL[searchPhotos] = searchPhotosEndpoint
 	mapURL[searchCollections] = searchCollectionsEndpoint
@@ -86,6 +91,9 @@ func getEndpoint(e endpoint) string {
 	return mapURL[e]
 }
 
+// SetupBaseUrl changes the default base URL for all clients in the process
+// that weren't created with WithBaseURL.
+// This function is DEPRECATED, use the WithBaseURL option instead.
 func SetupBaseUrl(url string) {
 	apiBaseURL = url
 	mapURL[base] = apiBaseURL
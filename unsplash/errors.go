@@ -23,8 +23,105 @@
 
 package unsplash
 
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
 //The following are implementing error interface
 
+// ErrorResponse occurs when the API responds with an error status code.
+// It carries the details of the failed call.
+// AuthorizationError, NotFoundError and RateLimitError wrap an ErrorResponse,
+// which can be retrieved using errors.As.
+type ErrorResponse struct {
+	// Response is the HTTP response returned by the API.
+	// Its body has already been read and closed.
+	Response *http.Response
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+	// Errors are the error messages returned by the API, if any.
+	Errors []string
+	// RequestURL is the URL of the request that failed.
+	RequestURL string
+	// RateLimit and RateLimitRemaining are the rate limit
+	// information returned along with the error.
+	RateLimit          int
+	RateLimitRemaining int
+	// Body is the raw body of the response.
+	Body []byte
+}
+
+func newErrorResponse(r *Response) *ErrorResponse {
+	e := &ErrorResponse{
+		Response:           r.httpResponse,
+		StatusCode:         r.httpResponse.StatusCode,
+		RateLimit:          r.RateLimit,
+		RateLimitRemaining: r.RateLimitRemaining,
+	}
+	if r.httpResponse.Request != nil {
+		e.RequestURL = r.httpResponse.Request.URL.String()
+	}
+	if r.body != nil {
+		e.Body = *r.body
+		//fails silently, not all errors come with a JSON body
+		var apiErrors struct {
+			Errors []string `json:"errors"`
+		}
+		if json.Unmarshal(e.Body, &apiErrors) == nil {
+			e.Errors = apiErrors.Errors
+		}
+	}
+	return e
+}
+
+func (e *ErrorResponse) Error() string {
+	var buf bytes.Buffer
+	if e.Response != nil && e.Response.Request != nil {
+		buf.WriteString(e.Response.Request.Method)
+		buf.WriteString(" ")
+	}
+	if e.RequestURL != "" {
+		buf.WriteString(e.RequestURL)
+		buf.WriteString(": ")
+	}
+	buf.WriteString(strconv.Itoa(e.StatusCode))
+	if len(e.Errors) != 0 {
+		buf.WriteString(" ")
+		buf.WriteString(strings.Join(e.Errors, ", "))
+	} else if len(e.Body) != 0 {
+		buf.WriteString(", Body: ")
+		buf.Write(e.Body)
+	}
+	return buf.String()
+}
+
+// Is reports if target is an *ErrorResponse with the same status code.
+// An ErrorResponse with a zero StatusCode matches any ErrorResponse, so
+//
+//	errors.Is(err, &ErrorResponse{StatusCode: 422})
+//
+// tells if err came from a 422 response.
+func (e *ErrorResponse) Is(target error) bool {
+	t, ok := target.(*ErrorResponse)
+	if !ok || t == nil {
+		return false
+	}
+	return t.StatusCode == 0 || t.StatusCode == e.StatusCode
+}
+
+// unwrap returns e as an error, taking care of nil.
+func (e *ErrorResponse) unwrap() error {
+	if e == nil {
+		return nil
+	}
+	return e
+}
+
 // IllegalArgumentError occurs when the argument to a function are
 // messed up
 type IllegalArgumentError struct {
@@ -46,22 +143,34 @@ func (e JSONUnmarshallingError) Error() string {
 
 // AuthorizationError occurs for an Unauthorized request
 type AuthorizationError struct {
-	ErrString string
+	ErrString     string
+	ErrorResponse *ErrorResponse
 }
 
 func (e AuthorizationError) Error() string {
 	return e.ErrString
 }
 
+// Unwrap returns the underlying ErrorResponse, if any.
+func (e AuthorizationError) Unwrap() error {
+	return e.ErrorResponse.unwrap()
+}
+
 // NotFoundError occurs when the resource queried returns a 404.
 type NotFoundError struct {
-	ErrString string
+	ErrString     string
+	ErrorResponse *ErrorResponse
 }
 
 func (e NotFoundError) Error() string {
 	return e.ErrString
 }
 
+// Unwrap returns the underlying ErrorResponse, if any.
+func (e NotFoundError) Unwrap() error {
+	return e.ErrorResponse.unwrap()
+}
+
 // InvalidPhotoOptError occurs when PhotoOpt.Valid() fails.
 type InvalidPhotoOptError struct {
 	ErrString string
@@ -71,6 +180,24 @@ func (e InvalidPhotoOptError) Error() string {
 	return e.ErrString
 }
 
+// InvalidImageOptError occurs when ImageOpt.Valid() fails.
+type InvalidImageOptError struct {
+	ErrString string
+}
+
+func (e InvalidImageOptError) Error() string {
+	return e.ErrString
+}
+
+// InvalidDownloadOptError occurs when DownloadOpt.Valid() fails.
+type InvalidDownloadOptError struct {
+	ErrString string
+}
+
+func (e InvalidDownloadOptError) Error() string {
+	return e.ErrString
+}
+
 // InvalidListOptError occurs when ListOpt.Valid() fails.
 type InvalidListOptError struct {
 	ErrString string
@@ -91,9 +218,166 @@ func (e InvalidStatsOptError) Error() string {
 
 // RateLimitError occurs when rate limit is reached for the API key.
 type RateLimitError struct {
-	ErrString string
+	ErrString     string
+	ErrorResponse *ErrorResponse
 }
 
 func (e RateLimitError) Error() string {
 	return e.ErrString
 }
+
+// Unwrap returns the underlying ErrorResponse, if any.
+func (e RateLimitError) Unwrap() error {
+	return e.ErrorResponse.unwrap()
+}
+
+// MissingScopeError occurs when a method needs a scope which the client
+// knows was not granted, see WithScopes.
+// No request is made to the API in that case.
+type MissingScopeError struct {
+	ErrString string
+	Scope     Scope
+}
+
+func (e MissingScopeError) Error() string {
+	return e.ErrString
+}
+
+// PageError occurs when a page couldn't be fetched by FetchAll.
+type PageError struct {
+	Page int
+	Err  error
+}
+
+func (e PageError) Error() string {
+	return "page " + strconv.Itoa(e.Page) + ": " + e.Err.Error()
+}
+
+// Unwrap returns the error the page failed with.
+func (e PageError) Unwrap() error {
+	return e.Err
+}
+
+// BulkError occurs when some of the pages requested by FetchAll
+// couldn't be fetched. Errors are sorted by page.
+type BulkError struct {
+	Errors []*PageError
+}
+
+func (e BulkError) Error() string {
+	var buf bytes.Buffer
+	buf.WriteString(strconv.Itoa(len(e.Errors)))
+	buf.WriteString(" page(s) could not be fetched")
+	for i, err := range e.Errors {
+		if i == 0 {
+			buf.WriteString(": ")
+		} else {
+			buf.WriteString("; ")
+		}
+		buf.WriteString(err.Error())
+	}
+	return buf.String()
+}
+
+// Unwrap returns the errors of the pages that failed.
+func (e BulkError) Unwrap() []error {
+	errs := make([]error, len(e.Errors))
+	for i, err := range e.Errors {
+		errs[i] = err
+	}
+	return errs
+}
+
+// Is reports whether any of the page errors matches target.
+// It lets errors.Is look into a BulkError on Go versions
+// that can't unwrap multiple errors.
+func (e BulkError) Is(target error) bool {
+	for _, err := range e.Errors {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// As finds the first page error that matches target.
+// It lets errors.As look into a BulkError on Go versions
+// that can't unwrap multiple errors.
+func (e BulkError) As(target interface{}) bool {
+	for _, err := range e.Errors {
+		if errors.As(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// PhotoDownloadError occurs when a photo couldn't be downloaded by a
+// Downloader.
+type PhotoDownloadError struct {
+	PhotoID string
+	Err     error
+}
+
+func (e PhotoDownloadError) Error() string {
+	return "photo " + e.PhotoID + ": " + e.Err.Error()
+}
+
+// Unwrap returns the error the download failed with.
+func (e PhotoDownloadError) Unwrap() error {
+	return e.Err
+}
+
+// DownloadError occurs when some of the photos given to a Downloader
+// couldn't be downloaded. Errors are in the order the photos were given.
+type DownloadError struct {
+	Errors []*PhotoDownloadError
+}
+
+func (e DownloadError) Error() string {
+	var buf bytes.Buffer
+	buf.WriteString(strconv.Itoa(len(e.Errors)))
+	buf.WriteString(" photo(s) could not be downloaded")
+	for i, err := range e.Errors {
+		if i == 0 {
+			buf.WriteString(": ")
+		} else {
+			buf.WriteString("; ")
+		}
+		buf.WriteString(err.Error())
+	}
+	return buf.String()
+}
+
+// Unwrap returns the errors of the downloads that failed.
+func (e DownloadError) Unwrap() []error {
+	errs := make([]error, len(e.Errors))
+	for i, err := range e.Errors {
+		errs[i] = err
+	}
+	return errs
+}
+
+// Is reports whether any of the download errors matches target.
+// It lets errors.Is look into a DownloadError on Go versions
+// that can't unwrap multiple errors.
+func (e DownloadError) Is(target error) bool {
+	for _, err := range e.Errors {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// As finds the first download error that matches target.
+// It lets errors.As look into a DownloadError on Go versions
+// that can't unwrap multiple errors.
+func (e DownloadError) As(target interface{}) bool {
+	for _, err := range e.Errors {
+		if errors.As(err, target) {
+			return true
+		}
+	}
+	return false
+}
@@ -24,8 +24,11 @@
 package unsplash
 
 import (
+	"errors"
 	"io/ioutil"
 	"log"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -84,3 +87,60 @@ func TestErrors(T *testing.T) {
 	log.Println(iso)
 	assert.Equal(true, ok)
 }
+
+func TestErrorResponse(T *testing.T) {
+	assert := assert.New(T)
+	log.SetOutput(ioutil.Discard)
+	status := 0
+	remaining := "42"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Ratelimit-Limit", "50")
+		w.Header().Set("X-Ratelimit-Remaining", remaining)
+		w.WriteHeader(status)
+		w.Write([]byte(`{"errors": ["Couldn't find Photo", "Try again"]}`))
+	}))
+	defer server.Close()
+	unsplash := New(nil, WithBaseURL(server.URL))
+
+	status = 404
+	_, _, err := unsplash.Photos.Photo("gopherPhoto", nil)
+	nfe, ok := err.(*NotFoundError)
+	assert.Equal(true, ok)
+	assert.NotNil(nfe)
+	var errResp *ErrorResponse
+	assert.Equal(true, errors.As(err, &errResp))
+	assert.Equal(404, errResp.StatusCode)
+	assert.Equal([]string{"Couldn't find Photo", "Try again"}, errResp.Errors)
+	assert.Equal(server.URL+"/photos/gopherPhoto", errResp.RequestURL)
+	assert.Equal(50, errResp.RateLimit)
+	assert.Equal(42, errResp.RateLimitRemaining)
+	assert.NotNil(errResp.Response)
+	assert.Equal("GET "+server.URL+"/photos/gopherPhoto: 404 Couldn't find Photo, Try again", errResp.Error())
+	assert.Equal(true, errors.Is(err, &ErrorResponse{StatusCode: 404}))
+	assert.Equal(true, errors.Is(err, &ErrorResponse{}))
+	assert.Equal(false, errors.Is(err, &ErrorResponse{StatusCode: 500}))
+
+	status = 422
+	_, _, err = unsplash.Photos.Photo("gopherPhoto", nil)
+	errResp, ok = err.(*ErrorResponse)
+	assert.Equal(true, ok)
+	assert.Equal(422, errResp.StatusCode)
+
+	status = 401
+	_, _, err = unsplash.Photos.Photo("gopherPhoto", nil)
+	var ae *AuthorizationError
+	assert.Equal(true, errors.As(err, &ae))
+	assert.Equal(true, errors.Is(err, &ErrorResponse{StatusCode: 401}))
+
+	status = 403
+	remaining = "0"
+	_, _, err = unsplash.Photos.Photo("gopherPhoto", nil)
+	var rle *RateLimitError
+	assert.Equal(true, errors.As(err, &rle))
+	assert.Equal(true, errors.As(err, &errResp))
+	assert.Equal(0, errResp.RateLimitRemaining)
+
+	// errors without an ErrorResponse don't unwrap to one
+	err = &RateLimitError{ErrString: "."}
+	assert.Equal(false, errors.As(err, &errResp))
+}
@@ -23,17 +23,20 @@
 
 package unsplash
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 // getPhotos can be used to query any endpoint which returns an array of Photos
-func (s *service) getPhotos(opt *ListOpt, endpoint string) (*[]Photo, *Response, error) {
+func (s *service) getPhotos(ctx context.Context, opt *ListOpt, endpoint string) (*[]Photo, *Response, error) {
 	if nil == opt {
 		opt = defaultListOpt
 	}
 	if !opt.Valid() {
 		return nil, nil, &InvalidListOptError{ErrString: "opt provided is not valid."}
 	}
-	req, err := newRequest(GET, endpoint, opt, nil)
+	req, err := s.client.newRequest(ctx, GET, endpoint, opt, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -51,14 +54,14 @@ func (s *service) getPhotos(opt *ListOpt, endpoint string) (*[]Photo, *Response,
 
 // getCollections can be used to query any endpoint which
 //returns an array of Collections
-func (s *service) getCollections(opt *ListOpt, endpoint string) (*[]Collection, *Response, error) {
+func (s *service) getCollections(ctx context.Context, opt *ListOpt, endpoint string) (*[]Collection, *Response, error) {
 	if nil == opt {
 		opt = defaultListOpt
 	}
 	if !opt.Valid() {
 		return nil, nil, &InvalidListOptError{ErrString: "opt provided is not valid."}
 	}
-	req, err := newRequest(GET, endpoint, opt, nil)
+	req, err := s.client.newRequest(ctx, GET, endpoint, opt, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -73,3 +76,26 @@ func (s *service) getCollections(opt *ListOpt, endpoint string) (*[]Collection,
 	}
 	return &collections, resp, nil
 }
+
+func (s *service) getUsers(ctx context.Context, opt *ListOpt, endpoint string) (*[]User, *Response, error) {
+	if nil == opt {
+		opt = defaultListOpt
+	}
+	if !opt.Valid() {
+		return nil, nil, &InvalidListOptError{ErrString: "opt provided is not valid."}
+	}
+	req, err := s.client.newRequest(ctx, GET, endpoint, opt, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	resp, err := s.client.do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	users := make([]User, 0)
+	err = json.Unmarshal(*resp.body, &users)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &users, resp, nil
+}
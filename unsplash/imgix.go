@@ -0,0 +1,224 @@
+// Copyright (c) 2017 Hardik Bagdi <hbagdi1@binghamton.edu>
+//
+// MIT License
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package unsplash
+
+import (
+	"github.com/google/go-querystring/query"
+)
+
+// ImageFit controls how an image is resized to the requested dimensions
+type ImageFit string
+
+// These constants show possible Fit modes of an ImageOpt.
+// See https://docs.imgix.com/apis/rendering/size/fit
+const (
+	FitClamp    ImageFit = "clamp"
+	FitClip     ImageFit = "clip"
+	FitCrop     ImageFit = "crop"
+	FitFaceArea ImageFit = "facearea"
+	FitFill     ImageFit = "fill"
+	FitFillMax  ImageFit = "fillmax"
+	FitMax      ImageFit = "max"
+	FitMin      ImageFit = "min"
+	FitScale    ImageFit = "scale"
+)
+
+var imageFits = []ImageFit{FitClamp, FitClip, FitCrop, FitFaceArea, FitFill, FitFillMax, FitMax, FitMin, FitScale}
+
+// ImageCrop controls which part of an image is kept when cropping
+type ImageCrop string
+
+// These constants show possible Crop modes of an ImageOpt.
+// See https://docs.imgix.com/apis/rendering/size/crop
+const (
+	CropTop        ImageCrop = "top"
+	CropBottom     ImageCrop = "bottom"
+	CropLeft       ImageCrop = "left"
+	CropRight      ImageCrop = "right"
+	CropFaces      ImageCrop = "faces"
+	CropFocalPoint ImageCrop = "focalpoint"
+	CropEdges      ImageCrop = "edges"
+	CropEntropy    ImageCrop = "entropy"
+)
+
+var imageCrops = []ImageCrop{CropTop, CropBottom, CropLeft, CropRight, CropFaces, CropFocalPoint, CropEdges, CropEntropy}
+
+// ImageFormat is the file format of an image
+type ImageFormat string
+
+// These constants show possible Formats of an ImageOpt.
+const (
+	FormatJPG  ImageFormat = "jpg"
+	FormatPJPG ImageFormat = "pjpg"
+	FormatPNG  ImageFormat = "png"
+	FormatGIF  ImageFormat = "gif"
+	FormatWebP ImageFormat = "webp"
+	FormatAVIF ImageFormat = "avif"
+)
+
+var imageFormats = []ImageFormat{FormatJPG, FormatPJPG, FormatPNG, FormatGIF, FormatWebP, FormatAVIF}
+
+// ImageAuto is an automatic optimization of an image
+type ImageAuto string
+
+// These constants show possible Auto optimizations of an ImageOpt.
+const (
+	AutoFormat   ImageAuto = "format"
+	AutoCompress ImageAuto = "compress"
+	AutoEnhance  ImageAuto = "enhance"
+	AutoRedEye   ImageAuto = "redeye"
+)
+
+var imageAutos = []ImageAuto{AutoFormat, AutoCompress, AutoEnhance, AutoRedEye}
+
+// ImageOpt lists the imgix parameters used to resize, crop and convert
+// the image of a photo. Fields left empty are not sent.
+type ImageOpt struct {
+	Width   int         `url:"w,omitempty"`
+	Height  int         `url:"h,omitempty"`
+	Fit     ImageFit    `url:"fit,omitempty"`
+	Crop    []ImageCrop `url:"crop,comma,omitempty"`
+	Format  ImageFormat `url:"fm,omitempty"`
+	Quality int         `url:"q,omitempty"`
+	DPR     float64     `url:"dpr,omitempty"`
+	Auto    []ImageAuto `url:"auto,comma,omitempty"`
+	Blur    int         `url:"blur,omitempty"`
+}
+
+// Valid validates the values in an ImageOpt
+func (opt *ImageOpt) Valid() bool {
+	return opt.validate() == nil
+}
+
+func (opt *ImageOpt) validate() error {
+	if opt.Width < 0 || opt.Height < 0 {
+		return &InvalidImageOptError{ErrString: "Width and Height cannot be negative"}
+	}
+	if opt.Fit != "" && !containsFit(imageFits, opt.Fit) {
+		return &InvalidImageOptError{ErrString: "Unknown fit " + string(opt.Fit)}
+	}
+	for _, crop := range opt.Crop {
+		if !containsCrop(imageCrops, crop) {
+			return &InvalidImageOptError{ErrString: "Unknown crop " + string(crop)}
+		}
+	}
+	if len(opt.Crop) != 0 && opt.Fit != FitCrop {
+		return &InvalidImageOptError{ErrString: "Crop can only be used with FitCrop"}
+	}
+	if opt.Fit == FitCrop && opt.Width == 0 && opt.Height == 0 {
+		return &InvalidImageOptError{ErrString: "FitCrop needs a Width or a Height"}
+	}
+	if opt.Format != "" && !containsFormat(imageFormats, opt.Format) {
+		return &InvalidImageOptError{ErrString: "Unknown format " + string(opt.Format)}
+	}
+	for _, auto := range opt.Auto {
+		if !containsAuto(imageAutos, auto) {
+			return &InvalidImageOptError{ErrString: "Unknown auto " + string(auto)}
+		}
+	}
+	if opt.Quality < 0 || opt.Quality > 100 {
+		return &InvalidImageOptError{ErrString: "Quality must be between 0 and 100"}
+	}
+	if opt.DPR < 0 || opt.DPR > 5 {
+		return &InvalidImageOptError{ErrString: "DPR must be between 0 and 5"}
+	}
+	if opt.DPR != 0 && opt.Width == 0 && opt.Height == 0 {
+		return &InvalidImageOptError{ErrString: "DPR needs a Width or a Height"}
+	}
+	if opt.Blur < 0 || opt.Blur > 2000 {
+		return &InvalidImageOptError{ErrString: "Blur must be between 0 and 2000"}
+	}
+	return nil
+}
+
+// ImageURL returns raw with the imgix parameters in opt applied.
+// Parameters already present in raw, like ixid and ixlib, are preserved
+// unless opt overrides them.
+func ImageURL(raw *URL, opt *ImageOpt) (*URL, error) {
+	if raw == nil || raw.URL == nil {
+		return nil, &IllegalArgumentError{ErrString: "URL cannot be nil"}
+	}
+	if opt == nil {
+		return nil, &IllegalArgumentError{ErrString: "ImageOpt cannot be nil"}
+	}
+	if err := opt.validate(); err != nil {
+		return nil, err
+	}
+	params, err := query.Values(opt)
+	if err != nil {
+		return nil, err
+	}
+	values := raw.Query()
+	for key, val := range params {
+		values[key] = val
+	}
+	u := *raw.URL
+	u.RawQuery = values.Encode()
+	return &URL{&u}, nil
+}
+
+// ImageURL returns the raw image URL of the photo with the imgix
+// parameters in opt applied.
+func (p *Photo) ImageURL(opt *ImageOpt) (*URL, error) {
+	if p.Urls == nil || p.Urls.Raw == nil {
+		return nil, &IllegalArgumentError{ErrString: "Photo has no raw URL"}
+	}
+	return ImageURL(p.Urls.Raw, opt)
+}
+
+func containsFit(fits []ImageFit, fit ImageFit) bool {
+	for _, val := range fits {
+		if val == fit {
+			return true
+		}
+	}
+	return false
+}
+
+func containsCrop(crops []ImageCrop, crop ImageCrop) bool {
+	for _, val := range crops {
+		if val == crop {
+			return true
+		}
+	}
+	return false
+}
+
+func containsFormat(formats []ImageFormat, format ImageFormat) bool {
+	for _, val := range formats {
+		if val == format {
+			return true
+		}
+	}
+	return false
+}
+
+func containsAuto(autos []ImageAuto, auto ImageAuto) bool {
+	for _, val := range autos {
+		if val == auto {
+			return true
+		}
+	}
+	return false
+}
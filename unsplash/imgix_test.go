@@ -0,0 +1,102 @@
+// Copyright (c) 2017 Hardik Bagdi <hbagdi1@binghamton.edu>
+//
+// MIT License
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package unsplash
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const rawPhotoJSON = `{"id": "gopherPhoto", "urls": {"raw":
+	"https://images.unsplash.com/photo-1461988320302-91bde64fc8e4?ixid=MnwxMjA3fDB8MXxhbGx8fHx8&ixlib=rb-1.2.1"}}`
+
+func TestImageOpt(T *testing.T) {
+	assert := assert.New(T)
+	var opt ImageOpt
+	assert.Equal(true, opt.Valid())
+	for _, invalid := range []ImageOpt{
+		{Width: -1},
+		{Fit: "stretch"},
+		{Crop: []ImageCrop{CropFaces}},
+		{Fit: FitCrop},
+		{Width: 100, Fit: FitCrop, Crop: []ImageCrop{"middle"}},
+		{Format: "bmp"},
+		{Auto: []ImageAuto{"magic"}},
+		{Quality: 101},
+		{DPR: 2},
+		{Width: 100, DPR: 6},
+		{Blur: 2001},
+	} {
+		assert.Equal(false, invalid.Valid(), "%+v", invalid)
+	}
+	opt = ImageOpt{
+		Width:   400,
+		Height:  300,
+		Fit:     FitCrop,
+		Crop:    []ImageCrop{CropFaces, CropEntropy},
+		Format:  FormatWebP,
+		Quality: 80,
+		DPR:     2,
+		Auto:    []ImageAuto{AutoFormat, AutoCompress},
+		Blur:    50,
+	}
+	assert.Equal(true, opt.Valid())
+}
+
+func TestImageURL(T *testing.T) {
+	assert := assert.New(T)
+	var photo Photo
+	assert.Nil(json.Unmarshal([]byte(rawPhotoJSON), &photo))
+
+	opt := &ImageOpt{Width: 400, Height: 300, Fit: FitCrop, Crop: []ImageCrop{CropFaces, CropEdges},
+		Format: FormatWebP, Quality: 75, DPR: 1.5, Auto: []ImageAuto{AutoCompress}}
+	u, err := photo.ImageURL(opt)
+	assert.Nil(err)
+	assert.Equal("https://images.unsplash.com/photo-1461988320302-91bde64fc8e4"+
+		"?auto=compress&crop=faces%2Cedges&dpr=1.5&fit=crop&fm=webp&h=300"+
+		"&ixid=MnwxMjA3fDB8MXxhbGx8fHx8&ixlib=rb-1.2.1&q=75&w=400", u.String())
+	//the photo is left untouched
+	assert.Equal("ixid=MnwxMjA3fDB8MXxhbGx8fHx8&ixlib=rb-1.2.1", photo.Urls.Raw.RawQuery)
+
+	//parameters already in the URL are overridden
+	u, err = ImageURL(u, &ImageOpt{Width: 200})
+	assert.Nil(err)
+	assert.Equal("200", u.Query().Get("w"))
+	assert.Equal("300", u.Query().Get("h"))
+
+	_, err = photo.ImageURL(&ImageOpt{Quality: 200})
+	assert.NotNil(err)
+	_, ok := err.(*InvalidImageOptError)
+	assert.Equal(true, ok)
+
+	_, err = photo.ImageURL(nil)
+	_, ok = err.(*IllegalArgumentError)
+	assert.Equal(true, ok)
+
+	_, err = (&Photo{}).ImageURL(opt)
+	_, ok = err.(*IllegalArgumentError)
+	assert.Equal(true, ok)
+}
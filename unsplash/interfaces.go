@@ -0,0 +1,201 @@
+// Copyright (c) 2017 Hardik Bagdi <hbagdi1@binghamton.edu>
+//
+// MIT License
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package unsplash
+
+import (
+	"context"
+	"io"
+)
+
+// PhotosAPI is the interface implemented by PhotosService.
+type PhotosAPI interface {
+	Photo(id string, photoOpt *PhotoOpt) (*Photo, *Response, error)
+	PhotoWithContext(ctx context.Context, id string, photoOpt *PhotoOpt) (*Photo, *Response, error)
+	Stats(id string) (*PhotoStats, *Response, error)
+	StatsWithContext(ctx context.Context, id string) (*PhotoStats, *Response, error)
+	Statistics(id string, opt *StatsOpt) (*PhotoStatistics, *Response, error)
+	StatisticsWithContext(ctx context.Context, id string, opt *StatsOpt) (*PhotoStatistics, *Response, error)
+	DownloadLink(id string) (*URL, *Response, error)
+	DownloadLinkWithContext(ctx context.Context, id string) (*URL, *Response, error)
+	Update(id string, opt *PhotoUpdateOpt) (*Photo, *Response, error)
+	UpdateWithContext(ctx context.Context, id string, opt *PhotoUpdateOpt) (*Photo, *Response, error)
+	TrackDownload(photo *Photo) (*URL, *Response, error)
+	TrackDownloadWithContext(ctx context.Context, photo *Photo) (*URL, *Response, error)
+	Download(photo *Photo, w io.Writer) (int64, error)
+	DownloadWithContext(ctx context.Context, photo *Photo, w io.Writer) (int64, error)
+	All(listOpt *ListOpt) (*[]Photo, *Response, error)
+	AllWithContext(ctx context.Context, listOpt *ListOpt) (*[]Photo, *Response, error)
+	AllPager(ctx context.Context, listOpt *ListOpt, pagerOpt *PagerOpt) *PhotoPager
+	Curated(listOpt *ListOpt) (*[]Photo, *Response, error)
+	CuratedWithContext(ctx context.Context, listOpt *ListOpt) (*[]Photo, *Response, error)
+	CuratedPager(ctx context.Context, listOpt *ListOpt, pagerOpt *PagerOpt) *PhotoPager
+	Random(opt *RandomPhotoOpt) (*[]Photo, *Response, error)
+	RandomWithContext(ctx context.Context, opt *RandomPhotoOpt) (*[]Photo, *Response, error)
+	Like(photoID string) (*Photo, *Response, error)
+	LikeWithContext(ctx context.Context, photoID string) (*Photo, *Response, error)
+	Unlike(photoID string) (*Photo, *Response, error)
+	UnlikeWithContext(ctx context.Context, photoID string) (*Photo, *Response, error)
+}
+
+// CollectionsAPI is the interface implemented by CollectionsService.
+type CollectionsAPI interface {
+	All(opt *ListOpt) (*[]Collection, *Response, error)
+	AllWithContext(ctx context.Context, opt *ListOpt) (*[]Collection, *Response, error)
+	AllPager(ctx context.Context, opt *ListOpt, pagerOpt *PagerOpt) *CollectionPager
+	Featured(opt *ListOpt) (*[]Collection, *Response, error)
+	FeaturedWithContext(ctx context.Context, opt *ListOpt) (*[]Collection, *Response, error)
+	FeaturedPager(ctx context.Context, opt *ListOpt, pagerOpt *PagerOpt) *CollectionPager
+	Curated(opt *ListOpt) (*[]Collection, *Response, error)
+	CuratedWithContext(ctx context.Context, opt *ListOpt) (*[]Collection, *Response, error)
+	CuratedPager(ctx context.Context, opt *ListOpt, pagerOpt *PagerOpt) *CollectionPager
+	Related(id string, opt *ListOpt) (*[]Collection, *Response, error)
+	RelatedWithContext(ctx context.Context, id string, opt *ListOpt) (*[]Collection, *Response, error)
+	RelatedPager(ctx context.Context, id string, opt *ListOpt, pagerOpt *PagerOpt) *CollectionPager
+	Photos(id string, opt *CollectionPhotosOpt) (*[]Photo, *Response, error)
+	PhotosWithContext(ctx context.Context, id string, opt *CollectionPhotosOpt) (*[]Photo, *Response, error)
+	PhotosPager(ctx context.Context, id string, opt *CollectionPhotosOpt, pagerOpt *PagerOpt) *PhotoPager
+	Collection(id string) (*Collection, *Response, error)
+	CollectionWithContext(ctx context.Context, id string) (*Collection, *Response, error)
+	Create(opt *CollectionOpt) (*Collection, *Response, error)
+	CreateWithContext(ctx context.Context, opt *CollectionOpt) (*Collection, *Response, error)
+	Update(collectionID int, opt *CollectionOpt) (*Collection, *Response, error)
+	UpdateWithContext(ctx context.Context, collectionID int, opt *CollectionOpt) (*Collection, *Response, error)
+	Delete(collectionID int) (*Response, error)
+	DeleteWithContext(ctx context.Context, collectionID int) (*Response, error)
+	AddPhoto(collectionID int, photoID string) (*Response, error)
+	AddPhotoWithContext(ctx context.Context, collectionID int, photoID string) (*Response, error)
+	RemovePhoto(collectionID int, photoID string) (*Response, error)
+	RemovePhotoWithContext(ctx context.Context, collectionID int, photoID string) (*Response, error)
+}
+
+// UsersAPI is the interface implemented by UsersService.
+type UsersAPI interface {
+	User(username string, imageOpt *ProfileImageOpt) (*User, error)
+	UserWithContext(ctx context.Context, username string, imageOpt *ProfileImageOpt) (*User, error)
+	Portfolio(username string) (*URL, error)
+	PortfolioWithContext(ctx context.Context, username string) (*URL, error)
+	Photos(username string, opt *ListOpt) (*[]Photo, *Response, error)
+	PhotosWithContext(ctx context.Context, username string, opt *ListOpt) (*[]Photo, *Response, error)
+	PhotosPager(ctx context.Context, username string, opt *ListOpt, pagerOpt *PagerOpt) *PhotoPager
+	LikedPhotos(username string, opt *ListOpt) (*[]Photo, *Response, error)
+	LikedPhotosWithContext(ctx context.Context, username string, opt *ListOpt) (*[]Photo, *Response, error)
+	LikedPhotosPager(ctx context.Context, username string, opt *ListOpt, pagerOpt *PagerOpt) *PhotoPager
+	Collections(username string, opt *ListOpt) (*[]Collection, *Response, error)
+	CollectionsWithContext(ctx context.Context, username string, opt *ListOpt) (*[]Collection, *Response, error)
+	CollectionsPager(ctx context.Context, username string, opt *ListOpt, pagerOpt *PagerOpt) *CollectionPager
+	Followers(username string, opt *ListOpt) (*[]User, *Response, error)
+	FollowersWithContext(ctx context.Context, username string, opt *ListOpt) (*[]User, *Response, error)
+	FollowersPager(ctx context.Context, username string, opt *ListOpt, pagerOpt *PagerOpt) *UserPager
+	Following(username string, opt *ListOpt) (*[]User, *Response, error)
+	FollowingWithContext(ctx context.Context, username string, opt *ListOpt) (*[]User, *Response, error)
+	FollowingPager(ctx context.Context, username string, opt *ListOpt, pagerOpt *PagerOpt) *UserPager
+	Follow(username string) (*Response, error)
+	FollowWithContext(ctx context.Context, username string) (*Response, error)
+	Unfollow(username string) (*Response, error)
+	UnfollowWithContext(ctx context.Context, username string) (*Response, error)
+	Statistics(username string, opt *StatsOpt) (*UserStatistics, *Response, error)
+	StatisticsWithContext(ctx context.Context, username string, opt *StatsOpt) (*UserStatistics, *Response, error)
+}
+
+// SearchAPI is the interface implemented by SearchService.
+type SearchAPI interface {
+	Users(opt *SearchOpt) (*UserSearchResult, *Response, error)
+	UsersWithContext(ctx context.Context, opt *SearchOpt) (*UserSearchResult, *Response, error)
+	UsersPager(ctx context.Context, opt *SearchOpt, pagerOpt *PagerOpt) *UserPager
+	Photos(opt *SearchOpt) (*PhotoSearchResult, *Response, error)
+	PhotosWithContext(ctx context.Context, opt *SearchOpt) (*PhotoSearchResult, *Response, error)
+	PhotosPager(ctx context.Context, opt *SearchOpt, pagerOpt *PagerOpt) *PhotoPager
+	FilterPhotos(opt *PhotoSearchOpt) (*PhotoSearchResult, *Response, error)
+	FilterPhotosWithContext(ctx context.Context, opt *PhotoSearchOpt) (*PhotoSearchResult, *Response, error)
+	FilterPhotosPager(ctx context.Context, opt *PhotoSearchOpt, pagerOpt *PagerOpt) *PhotoPager
+	Collections(opt *SearchOpt) (*CollectionSearchResult, *Response, error)
+	CollectionsWithContext(ctx context.Context, opt *SearchOpt) (*CollectionSearchResult, *Response, error)
+	CollectionsPager(ctx context.Context, opt *SearchOpt, pagerOpt *PagerOpt) *CollectionPager
+}
+
+// TopicsAPI is the interface implemented by TopicsService.
+type TopicsAPI interface {
+	All(opt *TopicListOpt) (*[]Topic, *Response, error)
+	AllWithContext(ctx context.Context, opt *TopicListOpt) (*[]Topic, *Response, error)
+	Topic(idOrSlug string) (*Topic, *Response, error)
+	TopicWithContext(ctx context.Context, idOrSlug string) (*Topic, *Response, error)
+	Photos(idOrSlug string, opt *TopicPhotosOpt) (*[]Photo, *Response, error)
+	PhotosWithContext(ctx context.Context, idOrSlug string, opt *TopicPhotosOpt) (*[]Photo, *Response, error)
+	PhotosPager(ctx context.Context, idOrSlug string, opt *TopicPhotosOpt, pagerOpt *PagerOpt) *PhotoPager
+}
+
+// API is the interface implemented by Unsplash.
+// Code depending on API rather than *Unsplash can be tested with the
+// mocks in the unsplashmock package.
+type API interface {
+	PhotosAPI() PhotosAPI
+	CollectionsAPI() CollectionsAPI
+	UsersAPI() UsersAPI
+	SearchAPI() SearchAPI
+	TopicsAPI() TopicsAPI
+	CurrentUser() (*User, *Response, error)
+	CurrentUserWithContext(ctx context.Context) (*User, *Response, error)
+	UpdateCurrentUser(updateInfo *UserUpdateInfo) (*User, *Response, error)
+	UpdateCurrentUserWithContext(ctx context.Context, updateInfo *UserUpdateInfo) (*User, *Response, error)
+	Stats() (*GlobalStats, *Response, error)
+	StatsWithContext(ctx context.Context) (*GlobalStats, *Response, error)
+	TotalStats() (*GlobalStats, *Response, error)
+	TotalStatsWithContext(ctx context.Context) (*GlobalStats, *Response, error)
+	MonthStats() (*MonthStats, *Response, error)
+	MonthStatsWithContext(ctx context.Context) (*MonthStats, *Response, error)
+}
+
+var (
+	_ API            = (*Unsplash)(nil)
+	_ PhotosAPI      = (*PhotosService)(nil)
+	_ CollectionsAPI = (*CollectionsService)(nil)
+	_ UsersAPI       = (*UsersService)(nil)
+	_ SearchAPI      = (*SearchService)(nil)
+	_ TopicsAPI      = (*TopicsService)(nil)
+)
+
+// PhotosAPI returns Photos as a PhotosAPI.
+func (s *Unsplash) PhotosAPI() PhotosAPI {
+	return s.Photos
+}
+
+// CollectionsAPI returns Collections as a CollectionsAPI.
+func (s *Unsplash) CollectionsAPI() CollectionsAPI {
+	return s.Collections
+}
+
+// UsersAPI returns Users as a UsersAPI.
+func (s *Unsplash) UsersAPI() UsersAPI {
+	return s.Users
+}
+
+// SearchAPI returns Search as a SearchAPI.
+func (s *Unsplash) SearchAPI() SearchAPI {
+	return s.Search
+}
+
+// TopicsAPI returns Topics as a TopicsAPI.
+func (s *Unsplash) TopicsAPI() TopicsAPI {
+	return s.Topics
+}
@@ -0,0 +1,121 @@
+// Copyright (c) 2017 Hardik Bagdi <hbagdi1@binghamton.edu>
+//
+// MIT License
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package unsplash
+
+import (
+	"context"
+
+	"golang.org/x/oauth2"
+)
+
+// OAuthEndpoint is the OAuth2 endpoint of Unsplash.
+var OAuthEndpoint = oauth2.Endpoint{
+	AuthURL:  "https://unsplash.com/oauth/authorize",
+	TokenURL: "https://unsplash.com/oauth/token",
+}
+
+// Authenticator implements the OAuth2 authorization code flow for Unsplash.
+// Send users to AuthCodeURL, then Exchange the code Unsplash redirects
+// back with for a token and use Client to get an Unsplash client
+// acting on their behalf.
+type Authenticator struct {
+	Config *oauth2.Config
+}
+
+// NewAuthenticator returns an Authenticator for the application with
+// clientID (the access key) and clientSecret, requesting scopes.
+// redirectURL must match one of the redirect URIs of the application.
+// If scopes is empty, only Public is requested.
+func NewAuthenticator(clientID, clientSecret, redirectURL string, scopes Scopes) (*Authenticator, error) {
+	if clientID == "" || clientSecret == "" {
+		return nil, &IllegalArgumentError{ErrString: "Client ID and secret cannot be empty"}
+	}
+	if redirectURL == "" {
+		return nil, &IllegalArgumentError{ErrString: "Redirect URL cannot be empty"}
+	}
+	if len(scopes) == 0 {
+		scopes = Scopes{Public}
+	}
+	if !scopes.Valid() {
+		return nil, &IllegalArgumentError{ErrString: "Unknown scope in " + scopes.String()}
+	}
+	return &Authenticator{
+		Config: &oauth2.Config{
+			ClientID:     clientID,
+			ClientSecret: clientSecret,
+			RedirectURL:  redirectURL,
+			Scopes:       scopes.Strings(),
+			Endpoint:     OAuthEndpoint,
+		},
+	}, nil
+}
+
+// AuthCodeURL returns the URL of the Unsplash page asking the user to
+// authorize the application.
+// state is passed back with the redirect and should be checked against
+// CSRF attacks.
+func (a *Authenticator) AuthCodeURL(state string) string {
+	return a.Config.AuthCodeURL(state)
+}
+
+// Exchange converts the authorization code from the redirect into a token.
+func (a *Authenticator) Exchange(ctx context.Context, code string) (*oauth2.Token, error) {
+	if code == "" {
+		return nil, &IllegalArgumentError{ErrString: "Authorization code cannot be empty"}
+	}
+	return a.Config.Exchange(ctx, code)
+}
+
+// Client returns an Unsplash client making requests on behalf of the user
+// who was granted token. opts are passed on to New().
+// If the token lists the scopes it was granted, the client checks them
+// before write operations, see WithScopes.
+func (a *Authenticator) Client(ctx context.Context, token *oauth2.Token, opts ...Option) *Unsplash {
+	if scopes := TokenScopes(token); scopes != nil {
+		opts = append([]Option{WithScopes(scopes)}, opts...)
+	}
+	return New(a.Config.Client(ctx, token), opts...)
+}
+
+// TokenScopes returns the scopes listed in the token response,
+// or nil if the token doesn't list them.
+func TokenScopes(token *oauth2.Token) Scopes {
+	if token == nil {
+		return nil
+	}
+	scope, ok := token.Extra("scope").(string)
+	if !ok {
+		return nil
+	}
+	return ParseScopes(scope)
+}
+
+// ExchangeClient is a shortcut for Exchange followed by Client.
+func (a *Authenticator) ExchangeClient(ctx context.Context, code string, opts ...Option) (*Unsplash, *oauth2.Token, error) {
+	token, err := a.Exchange(ctx, code)
+	if err != nil {
+		return nil, nil, err
+	}
+	return a.Client(ctx, token, opts...), token, nil
+}
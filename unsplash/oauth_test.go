@@ -0,0 +1,173 @@
+// Copyright (c) 2017 Hardik Bagdi <hbagdi1@binghamton.edu>
+//
+// MIT License
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package unsplash
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScopes(T *testing.T) {
+	assert := assert.New(T)
+	scopes := ParseScopes("public  read_user write_likes")
+	assert.Equal(Scopes{Public, ReadUser, WriteLikes}, scopes)
+	assert.Equal(true, scopes.Has(WriteLikes))
+	assert.Equal(false, scopes.Has(WriteCollections))
+	assert.Equal(true, scopes.Valid())
+	assert.Equal("public read_user write_likes", scopes.String())
+	assert.Equal(false, Scopes{Public, "write_everything"}.Valid())
+	assert.Equal(0, len(ParseScopes("")))
+
+	//the constants can still be used as strings
+	strs := []string{Public, ReadUser}
+	assert.Equal(Scopes{Public, ReadUser}.Strings(), strs)
+}
+
+func TestAuthenticator(T *testing.T) {
+	assert := assert.New(T)
+	log.SetOutput(ioutil.Discard)
+	var form url.Values
+	var auth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth/token":
+			r.ParseForm()
+			form = r.PostForm
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `{"access_token": "gopherToken", "token_type": "bearer",
+				"scope": "public write_likes", "created_at": 1436544465}`)
+		case "/me":
+			auth = r.Header.Get("Authorization")
+			fmt.Fprint(w, `{"username": "gopher"}`)
+		default:
+			w.WriteHeader(404)
+		}
+	}))
+	defer server.Close()
+
+	_, err := NewAuthenticator("", "secret", "https://example.com/callback", nil)
+	assert.NotNil(err)
+	_, err = NewAuthenticator("id", "secret", "", nil)
+	assert.NotNil(err)
+	_, err = NewAuthenticator("id", "secret", "https://example.com/callback", Scopes{"write_everything"})
+	assert.NotNil(err)
+	_, ok := err.(*IllegalArgumentError)
+	assert.Equal(true, ok)
+
+	a, err := NewAuthenticator("id", "secret", "https://example.com/callback", nil)
+	assert.Nil(err)
+	assert.Equal([]string{"public"}, a.Config.Scopes)
+
+	a, err = NewAuthenticator("id", "secret", "https://example.com/callback", Scopes{Public, WriteLikes})
+	assert.Nil(err)
+	authURL, err := url.Parse(a.AuthCodeURL("xyz"))
+	assert.Nil(err)
+	assert.Equal("unsplash.com", authURL.Host)
+	assert.Equal("/oauth/authorize", authURL.Path)
+	query := authURL.Query()
+	assert.Equal("id", query.Get("client_id"))
+	assert.Equal("code", query.Get("response_type"))
+	assert.Equal("https://example.com/callback", query.Get("redirect_uri"))
+	assert.Equal("public write_likes", query.Get("scope"))
+	assert.Equal("xyz", query.Get("state"))
+
+	_, err = a.Exchange(context.Background(), "")
+	assert.NotNil(err)
+
+	a.Config.Endpoint.TokenURL = server.URL + "/oauth/token"
+	unsplash, token, err := a.ExchangeClient(context.Background(), "gopherCode", WithBaseURL(server.URL))
+	assert.Nil(err)
+	assert.Equal("gopherToken", token.AccessToken)
+	assert.Equal("gopherCode", form.Get("code"))
+	assert.Equal("authorization_code", form.Get("grant_type"))
+
+	user, _, err := unsplash.CurrentUser()
+	assert.Nil(err)
+	assert.Equal("gopher", *user.Username)
+	assert.Equal("Bearer gopherToken", auth)
+	assert.Equal(Scopes{Public, WriteLikes}, TokenScopes(token))
+	assert.Equal(Scopes{Public, WriteLikes}, unsplash.scopes)
+}
+
+func TestScopePreflight(T *testing.T) {
+	assert := assert.New(T)
+	log.SetOutput(ioutil.Discard)
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(201)
+		fmt.Fprint(w, `{"id": 206}`)
+	}))
+	defer server.Close()
+
+	unsplash := New(nil, WithBaseURL(server.URL), WithScopes(Scopes{Public, WriteLikes}))
+	title := "gophers"
+	description := "gopher"
+	calls := map[Scope]func() error{
+		WriteCollections: func() error {
+			_, _, err := unsplash.Collections.Create(&CollectionOpt{Title: &title})
+			return err
+		},
+		WritePhotos: func() error {
+			_, _, err := unsplash.Photos.Update("gopherPhoto", &PhotoUpdateOpt{Description: &description})
+			return err
+		},
+		WriteFollowers: func() error {
+			_, err := unsplash.Users.Follow("gopher")
+			return err
+		},
+		WriteUser: func() error {
+			_, _, err := unsplash.UpdateCurrentUser(&UserUpdateInfo{Bio: "gopher"})
+			return err
+		},
+	}
+	for scope, call := range calls {
+		err := call()
+		assert.NotNil(err)
+		scopeErr, ok := err.(*MissingScopeError)
+		assert.Equal(true, ok)
+		assert.Equal(scope, scopeErr.Scope)
+		assert.Contains(scopeErr.Error(), string(scope))
+	}
+	assert.Equal(0, requests)
+
+	_, _, err := unsplash.Photos.Like("gopherPhoto")
+	_, ok := err.(*MissingScopeError)
+	assert.Equal(false, ok)
+	assert.Equal(1, requests)
+
+	//unknown scopes are not checked
+	unsplash = New(nil, WithBaseURL(server.URL))
+	_, _, err = unsplash.Collections.Create(&CollectionOpt{Title: &title})
+	assert.Nil(err)
+	assert.Equal(2, requests)
+}
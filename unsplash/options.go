@@ -0,0 +1,102 @@
+// Copyright (c) 2017 Hardik Bagdi <hbagdi1@binghamton.edu>
+//
+// MIT License
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package unsplash
+
+import (
+	"net/http"
+	"strings"
+)
+
+// Option configures an Unsplash client.
+// Options are passed to New() or NewWithClientID().
+type Option func(*Unsplash)
+
+// WithBaseURL sets the base URL all API requests made by the client are
+// resolved against, for example a staging proxy.
+// Defaults to https://api.unsplash.com/.
+func WithBaseURL(baseURL string) Option {
+	return func(u *Unsplash) {
+		if baseURL != "" && !strings.HasSuffix(baseURL, "/") {
+			baseURL += "/"
+		}
+		u.baseURL = baseURL
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+func WithUserAgent(userAgent string) Option {
+	return func(u *Unsplash) {
+		u.userAgent = userAgent
+	}
+}
+
+// WithHeader adds a header that is sent with every request.
+// It can be used multiple times to add several headers.
+func WithHeader(key, value string) Option {
+	return func(u *Unsplash) {
+		if u.headers == nil {
+			u.headers = make(http.Header)
+		}
+		u.headers.Add(key, value)
+	}
+}
+
+// WithAcceptVersion sets the Accept-Version header used to select
+// the version of the API. Defaults to v1.
+func WithAcceptVersion(version string) Option {
+	return func(u *Unsplash) {
+		if version != "" {
+			u.acceptVersion = version
+		}
+	}
+}
+
+// WithDownloadTracking makes PhotosService.Download track every download
+// with PhotosService.TrackDownload, keeping the application compliant
+// with the API guidelines.
+func WithDownloadTracking() Option {
+	return func(u *Unsplash) {
+		u.trackDownloads = true
+	}
+}
+
+// WithImageClient sets the HTTP client image files are downloaded with,
+// by PhotosService.Download and Downloader.
+// Image hosts are not part of the API, so client must not carry any
+// credentials. Defaults to http.DefaultClient.
+func WithImageClient(client *http.Client) Option {
+	return func(u *Unsplash) {
+		u.imageClient = client
+	}
+}
+
+// WithScopes tells the client which scopes its token was granted.
+// Methods needing a scope outside of these then fail with a
+// MissingScopeError without making a request.
+// By default the granted scopes are unknown and no checks are made.
+func WithScopes(scopes Scopes) Option {
+	return func(u *Unsplash) {
+		u.scopes = scopes
+	}
+}
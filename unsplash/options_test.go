@@ -0,0 +1,79 @@
+// Copyright (c) 2017 Hardik Bagdi <hbagdi1@binghamton.edu>
+//
+// MIT License
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package unsplash
+
+import (
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientOptions(T *testing.T) {
+	assert := assert.New(T)
+	log.SetOutput(ioutil.Discard)
+
+	var staging, production *http.Request
+	stagingServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		staging = r
+		w.Write([]byte(`{"photos": 1}`))
+	}))
+	defer stagingServer.Close()
+	productionServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		production = r
+		w.Write([]byte(`{"photos": 2}`))
+	}))
+	defer productionServer.Close()
+
+	stagingClient := New(nil,
+		WithBaseURL(stagingServer.URL+"/api"),
+		WithUserAgent("gopher/1.0"),
+		WithHeader("X-Gopher", "yes"),
+		WithAcceptVersion("v2"))
+	productionClient := NewWithClientID(nil, "HARDCODED_TEST",
+		WithBaseURL(productionServer.URL+"/"))
+
+	stats, _, err := stagingClient.TotalStats()
+	assert.Nil(err)
+	assert.Equal(uint64(1), stats.Photos)
+	stats, _, err = productionClient.TotalStats()
+	assert.Nil(err)
+	assert.Equal(uint64(2), stats.Photos)
+
+	assert.NotNil(staging)
+	assert.Equal("/api/"+getEndpoint(globalStats), staging.URL.Path)
+	assert.Equal("gopher/1.0", staging.Header.Get("User-Agent"))
+	assert.Equal("yes", staging.Header.Get("X-Gopher"))
+	assert.Equal("v2", staging.Header.Get("Accept-Version"))
+	assert.Equal("", staging.Header.Get("Authorization"))
+
+	assert.NotNil(production)
+	assert.Equal("/"+getEndpoint(globalStats), production.URL.Path)
+	assert.Equal("", production.Header.Get("X-Gopher"))
+	assert.Equal("v1", production.Header.Get("Accept-Version"))
+	assert.Equal("Client-ID HARDCODED_TEST", production.Header.Get("Authorization"))
+}
@@ -0,0 +1,330 @@
+// Copyright (c) 2017 Hardik Bagdi <hbagdi1@binghamton.edu>
+//
+// MIT License
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package unsplash
+
+import "context"
+
+// PagerOpt limits how far a pager goes. Zero values mean no limit.
+type PagerOpt struct {
+	// MaxItems is the maximum number of items returned by the pager.
+	MaxItems int
+	// MaxPages is the maximum number of pages requested from the API.
+	MaxPages int
+}
+
+// Valid validates a PagerOpt
+func (opt *PagerOpt) Valid() bool {
+	return opt.MaxItems >= 0 && opt.MaxPages >= 0
+}
+
+// pager holds the state shared by PhotoPager, CollectionPager and UserPager.
+type pager struct {
+	ctx    context.Context
+	limits PagerOpt
+	// page is the next page to be requested
+	page  int
+	pages int
+	items int
+	// index is the position of the current item in the current page
+	// and count the number of items in that page
+	index int
+	count int
+	last  bool
+	err   error
+	resp  *Response
+}
+
+func newPager(ctx context.Context, page int, limits *PagerOpt) pager {
+	p := pager{ctx: ctx, page: page}
+	if p.page <= 0 {
+		p.page = 1
+	}
+	if limits != nil {
+		if !limits.Valid() {
+			p.err = &IllegalArgumentError{ErrString: "PagerOpt provided is not valid."}
+		}
+		p.limits = *limits
+	}
+	return p
+}
+
+// advance moves to the next item, requesting the next page using fetch
+// when the current one is used up. fetch returns the number of items in
+// the page.
+func (p *pager) advance(fetch func(ctx context.Context, page int) (int, *Response, error)) bool {
+	if p.err != nil || p.budget() == 0 {
+		return false
+	}
+	for p.index >= p.count {
+		if p.last || (p.limits.MaxPages > 0 && p.pages >= p.limits.MaxPages) {
+			return false
+		}
+		n, resp, err := fetch(p.ctx, p.page)
+		if err != nil {
+			p.err = err
+			return false
+		}
+		p.resp = resp
+		p.page++
+		p.pages++
+		p.index = 0
+		p.count = n
+		p.last = !resp.HasNextPage || n == 0
+	}
+	p.index++
+	p.items++
+	return true
+}
+
+// budget returns how many more items the pager may return,
+// or -1 if there is no limit.
+func (p *pager) budget() int {
+	if p.limits.MaxItems == 0 {
+		return -1
+	}
+	if p.items >= p.limits.MaxItems {
+		return 0
+	}
+	return p.limits.MaxItems - p.items
+}
+
+// Err returns the error that stopped the pager, if any.
+func (p *pager) Err() error {
+	return p.err
+}
+
+// Response returns the Response of the last page requested.
+func (p *pager) Response() *Response {
+	return p.resp
+}
+
+// PhotoPager iterates over photos, one at a time, requesting pages from
+// the API as needed.
+//
+//	pager := unsplash.Photos.AllPager(ctx, nil, &PagerOpt{MaxItems: 100})
+//	for pager.Next() {
+//	  photo := pager.Photo()
+//	}
+//	if err := pager.Err(); err != nil {
+//	  //handle error
+//	}
+type PhotoPager struct {
+	pager
+	fetch  func(ctx context.Context, page int) (*[]Photo, *Response, error)
+	photos []Photo
+}
+
+// Next advances the pager to the next photo. It returns false once there
+// are no more photos, a limit is reached or an error occurs.
+func (p *PhotoPager) Next() bool {
+	return p.advance(func(ctx context.Context, page int) (int, *Response, error) {
+		photos, resp, err := p.fetch(ctx, page)
+		if err != nil {
+			return 0, nil, err
+		}
+		p.photos = *photos
+		return len(p.photos), resp, nil
+	})
+}
+
+// Photo returns the current photo.
+func (p *PhotoPager) Photo() *Photo {
+	if p.index == 0 {
+		return nil
+	}
+	return &p.photos[p.index-1]
+}
+
+// CollectionPager iterates over collections, one at a time,
+// requesting pages from the API as needed.
+type CollectionPager struct {
+	pager
+	fetch       func(ctx context.Context, page int) (*[]Collection, *Response, error)
+	collections []Collection
+}
+
+// Next advances the pager to the next collection. It returns false once
+// there are no more collections, a limit is reached or an error occurs.
+func (p *CollectionPager) Next() bool {
+	return p.advance(func(ctx context.Context, page int) (int, *Response, error) {
+		collections, resp, err := p.fetch(ctx, page)
+		if err != nil {
+			return 0, nil, err
+		}
+		p.collections = *collections
+		return len(p.collections), resp, nil
+	})
+}
+
+// Collection returns the current collection.
+func (p *CollectionPager) Collection() *Collection {
+	if p.index == 0 {
+		return nil
+	}
+	return &p.collections[p.index-1]
+}
+
+// UserPager iterates over users, one at a time, requesting pages from
+// the API as needed.
+type UserPager struct {
+	pager
+	fetch func(ctx context.Context, page int) (*[]User, *Response, error)
+	users []User
+}
+
+// Next advances the pager to the next user. It returns false once there
+// are no more users, a limit is reached or an error occurs.
+func (p *UserPager) Next() bool {
+	return p.advance(func(ctx context.Context, page int) (int, *Response, error) {
+		users, resp, err := p.fetch(ctx, page)
+		if err != nil {
+			return 0, nil, err
+		}
+		p.users = *users
+		return len(p.users), resp, nil
+	})
+}
+
+// User returns the current user.
+func (p *UserPager) User() *User {
+	if p.index == 0 {
+		return nil
+	}
+	return &p.users[p.index-1]
+}
+
+// NewPhotoPager returns a PhotoPager requesting pages, starting with page
+// or the first one if page is 0, from fetch instead of the API.
+// It is meant for fakes and mocks.
+// A nil Response from fetch is taken as the last page.
+func NewPhotoPager(ctx context.Context, page int, fetch func(ctx context.Context, page int) (*[]Photo, *Response, error), limits *PagerOpt) *PhotoPager {
+	p := &PhotoPager{pager: newPager(ctx, page, limits)}
+	p.fetch = func(ctx context.Context, page int) (*[]Photo, *Response, error) {
+		photos, resp, err := fetch(ctx, page)
+		if err == nil && photos == nil {
+			photos = &[]Photo{}
+		}
+		return photos, lastPage(resp), err
+	}
+	return p
+}
+
+// NewCollectionPager is like NewPhotoPager but for collections.
+func NewCollectionPager(ctx context.Context, page int, fetch func(ctx context.Context, page int) (*[]Collection, *Response, error), limits *PagerOpt) *CollectionPager {
+	p := &CollectionPager{pager: newPager(ctx, page, limits)}
+	p.fetch = func(ctx context.Context, page int) (*[]Collection, *Response, error) {
+		collections, resp, err := fetch(ctx, page)
+		if err == nil && collections == nil {
+			collections = &[]Collection{}
+		}
+		return collections, lastPage(resp), err
+	}
+	return p
+}
+
+// NewUserPager is like NewPhotoPager but for users.
+func NewUserPager(ctx context.Context, page int, fetch func(ctx context.Context, page int) (*[]User, *Response, error), limits *PagerOpt) *UserPager {
+	p := &UserPager{pager: newPager(ctx, page, limits)}
+	p.fetch = func(ctx context.Context, page int) (*[]User, *Response, error) {
+		users, resp, err := fetch(ctx, page)
+		if err == nil && users == nil {
+			users = &[]User{}
+		}
+		return users, lastPage(resp), err
+	}
+	return p
+}
+
+// lastPage returns resp, or an empty Response without a next page if nil.
+func lastPage(resp *Response) *Response {
+	if resp == nil {
+		return &Response{}
+	}
+	return resp
+}
+
+// photoPager returns a PhotoPager over any endpoint
+// which returns an array of Photos.
+func (s *service) photoPager(ctx context.Context, opt *ListOpt, endpoint string, limits *PagerOpt) *PhotoPager {
+	if nil == opt {
+		opt = defaultListOpt
+	}
+	listOpt := *opt
+	p := &PhotoPager{pager: newPager(ctx, listOpt.Page, limits)}
+	p.fetch = func(ctx context.Context, page int) (*[]Photo, *Response, error) {
+		pageOpt := listOpt
+		pageOpt.Page = page
+		return s.getPhotos(ctx, &pageOpt, endpoint)
+	}
+	return p
+}
+
+// collectionPager returns a CollectionPager over any endpoint
+// which returns an array of Collections.
+func (s *service) collectionPager(ctx context.Context, opt *ListOpt, endpoint string, limits *PagerOpt) *CollectionPager {
+	if nil == opt {
+		opt = defaultListOpt
+	}
+	listOpt := *opt
+	p := &CollectionPager{pager: newPager(ctx, listOpt.Page, limits)}
+	p.fetch = func(ctx context.Context, page int) (*[]Collection, *Response, error) {
+		pageOpt := listOpt
+		pageOpt.Page = page
+		return s.getCollections(ctx, &pageOpt, endpoint)
+	}
+	return p
+}
+
+// userPager returns a UserPager over any endpoint
+// which returns an array of Users.
+func (s *service) userPager(ctx context.Context, opt *ListOpt, endpoint string, limits *PagerOpt) *UserPager {
+	if nil == opt {
+		opt = defaultListOpt
+	}
+	listOpt := *opt
+	p := &UserPager{pager: newPager(ctx, listOpt.Page, limits)}
+	p.fetch = func(ctx context.Context, page int) (*[]User, *Response, error) {
+		pageOpt := listOpt
+		pageOpt.Page = page
+		return s.getUsers(ctx, &pageOpt, endpoint)
+	}
+	return p
+}
+
+// populateSearchPagingInfo fills in the paging information of a search
+// response, which comes without a Link header, from the search result.
+func (r *Response) populateSearchPagingInfo(page int, totalPages *int) {
+	if totalPages == nil || *totalPages == 0 {
+		return
+	}
+	r.FirstPage = 1
+	r.LastPage = *totalPages
+	if page > 1 {
+		r.PrevPage = page - 1
+	}
+	if page < *totalPages {
+		r.NextPage = page + 1
+		r.HasNextPage = true
+	}
+}
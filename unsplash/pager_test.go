@@ -0,0 +1,191 @@
+// Copyright (c) 2017 Hardik Bagdi <hbagdi1@binghamton.edu>
+//
+// MIT License
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package unsplash
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// pagedServer serves 3 pages of 2 photos each on /photos and
+// /search/photos, and fails with a 500 on /users/gopher/photos?page=2.
+func pagedServer() (*httptest.Server, *[]int) {
+	var pages []int
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		pages = append(pages, page)
+		if r.URL.Path == "/users/gopher/photos" && page == 2 {
+			w.WriteHeader(500)
+			return
+		}
+		photos := fmt.Sprintf(`[{"id": "photo%d-1"}, {"id": "photo%d-2"}]`, page, page)
+		if r.URL.Path == "/search/photos" {
+			fmt.Fprintf(w, `{"total": 6, "total_pages": 3, "results": %v}`, photos)
+			return
+		}
+		link := fmt.Sprintf(`<%v/photos?page=1>; rel="first", <%v/photos?page=3>; rel="last"`, server.URL, server.URL)
+		if page < 3 {
+			link += fmt.Sprintf(`, <%v/photos?page=%d>; rel="next"`, server.URL, page+1)
+		}
+		w.Header().Set("Link", link)
+		w.Write([]byte(photos))
+	}))
+	return server, &pages
+}
+
+func TestPhotoPager(T *testing.T) {
+	assert := assert.New(T)
+	log.SetOutput(ioutil.Discard)
+	server, pages := pagedServer()
+	defer server.Close()
+	unsplash := New(nil, WithBaseURL(server.URL))
+	ctx := context.Background()
+
+	pager := unsplash.Photos.AllPager(ctx, &ListOpt{PerPage: 2}, nil)
+	assert.Nil(pager.Photo())
+	var ids []string
+	for pager.Next() {
+		ids = append(ids, *pager.Photo().ID)
+	}
+	assert.Nil(pager.Err())
+	assert.Equal([]string{"photo1-1", "photo1-2", "photo2-1", "photo2-2", "photo3-1", "photo3-2"}, ids)
+	assert.Equal([]int{1, 2, 3}, *pages)
+	assert.Equal(3, pager.Response().LastPage)
+	assert.Equal(false, pager.Next())
+
+	*pages = nil
+	ids = nil
+	pager = unsplash.Photos.AllPager(ctx, &ListOpt{Page: 2}, &PagerOpt{MaxItems: 3})
+	for pager.Next() {
+		ids = append(ids, *pager.Photo().ID)
+	}
+	assert.Nil(pager.Err())
+	assert.Equal([]string{"photo2-1", "photo2-2", "photo3-1"}, ids)
+	assert.Equal([]int{2, 3}, *pages)
+
+	*pages = nil
+	ids = nil
+	pager = unsplash.Search.PhotosPager(ctx, &SearchOpt{Query: "gopher"}, &PagerOpt{MaxPages: 2})
+	for pager.Next() {
+		ids = append(ids, *pager.Photo().ID)
+	}
+	assert.Nil(pager.Err())
+	assert.Equal([]string{"photo1-1", "photo1-2", "photo2-1", "photo2-2"}, ids)
+	assert.Equal([]int{1, 2}, *pages)
+
+	*pages = nil
+	pager = unsplash.Search.PhotosPager(ctx, &SearchOpt{Query: "gopher", Page: 3}, nil)
+	assert.Equal(true, pager.Next())
+	assert.Equal(true, pager.Next())
+	assert.Equal(false, pager.Next())
+	assert.Nil(pager.Err())
+	assert.Equal([]int{3}, *pages)
+
+	// errors stop the pager
+	ids = nil
+	pager = unsplash.Users.PhotosPager(ctx, "gopher", nil, nil)
+	for pager.Next() {
+		ids = append(ids, *pager.Photo().ID)
+	}
+	assert.Equal([]string{"photo1-1", "photo1-2"}, ids)
+	_, ok := pager.Err().(*ErrorResponse)
+	assert.Equal(true, ok)
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	pager = unsplash.Photos.AllPager(cancelled, nil, nil)
+	assert.Equal(false, pager.Next())
+	assert.Equal(context.Canceled, pager.Err())
+
+	pager = unsplash.Users.PhotosPager(ctx, "", nil, nil)
+	assert.Equal(false, pager.Next())
+	_, ok = pager.Err().(*IllegalArgumentError)
+	assert.Equal(true, ok)
+
+	pager = unsplash.Photos.AllPager(ctx, nil, &PagerOpt{MaxItems: -1})
+	assert.Equal(false, pager.Next())
+	_, ok = pager.Err().(*IllegalArgumentError)
+	assert.Equal(true, ok)
+
+	pager = unsplash.Photos.AllPager(ctx, &ListOpt{OrderBy: "gopher"}, nil)
+	assert.Equal(false, pager.Next())
+	_, ok = pager.Err().(*InvalidListOptError)
+	assert.Equal(true, ok)
+}
+
+func TestCollectionAndUserPager(T *testing.T) {
+	assert := assert.New(T)
+	log.SetOutput(ioutil.Discard)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/search/users":
+			w.Write([]byte(`{"total": 1, "total_pages": 1, "results": [{"id": "gopher"}]}`))
+		case "/search/collections":
+			w.Write([]byte(`{"total": 0, "total_pages": 0, "results": []}`))
+		default:
+			w.Write([]byte(`[{"id": 42}, {"id": 43}]`))
+		}
+	}))
+	defer server.Close()
+	unsplash := New(nil, WithBaseURL(server.URL))
+	ctx := context.Background()
+
+	collections := unsplash.Collections.FeaturedPager(ctx, nil, nil)
+	assert.Nil(collections.Collection())
+	assert.Equal(true, collections.Next())
+	assert.Equal(42, *collections.Collection().ID)
+	assert.Equal(true, collections.Next())
+	assert.Equal(43, *collections.Collection().ID)
+	// no Link header, no next page
+	assert.Equal(false, collections.Next())
+	assert.Nil(collections.Err())
+
+	collections = unsplash.Search.CollectionsPager(ctx, &SearchOpt{Query: "gopher"}, nil)
+	assert.Equal(false, collections.Next())
+	assert.Nil(collections.Err())
+
+	collections = unsplash.Collections.RelatedPager(ctx, "", nil, nil)
+	assert.Equal(false, collections.Next())
+	assert.NotNil(collections.Err())
+
+	users := unsplash.Search.UsersPager(ctx, &SearchOpt{Query: "gopher"}, nil)
+	assert.Nil(users.User())
+	assert.Equal(true, users.Next())
+	assert.Equal("gopher", *users.User().ID)
+	assert.Equal(false, users.Next())
+	assert.Nil(users.Err())
+
+	users = unsplash.Search.UsersPager(ctx, nil, nil)
+	assert.Equal(false, users.Next())
+	assert.NotNil(users.Err())
+}
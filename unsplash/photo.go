@@ -30,6 +30,7 @@ import (
 
 //ExifData for an image
 type ExifData struct {
+	Name         *string `json:"name"`
 	Make         *string `json:"make"`
 	Model        *string `json:"model"`
 	ExposureTime *string `json:"exposure_time"`
@@ -44,48 +45,84 @@ type Tag struct {
 	Title *string `json:"title"`
 }
 
+// Position is the geographic position where a photo was taken
+type Position struct {
+	Latitude  *float64 `json:"latitude"`
+	Longitude *float64 `json:"longitude"`
+}
+
+// Location is the place where a photo was taken
+type Location struct {
+	Title    *string   `json:"title"`
+	Name     *string   `json:"name"`
+	City     *string   `json:"city"`
+	Country  *string   `json:"country"`
+	Position *Position `json:"position"`
+}
+
+// PhotoUrls contains URLs to the image of a photo in various sizes
+type PhotoUrls struct {
+	Raw     *URL `json:"raw"`
+	Full    *URL `json:"full"`
+	Regular *URL `json:"regular"`
+	Small   *URL `json:"small"`
+	Thumb   *URL `json:"thumb"`
+	SmallS3 *URL `json:"small_s3"`
+	Custom  *URL `json:"custom"`
+}
+
+// PhotoLinks contains URLs related to a photo
+type PhotoLinks struct {
+	Self             *URL `json:"self"`
+	HTML             *URL `json:"html"`
+	Download         *URL `json:"download"`
+	DownloadLocation *URL `json:"download_location"`
+}
+
+// Sponsorship describes the sponsor of a promoted photo
+type Sponsorship struct {
+	ImpressionUrls *[]URL  `json:"impression_urls"`
+	Tagline        *string `json:"tagline"`
+	TaglineURL     *URL    `json:"tagline_url"`
+	Sponsor        *User   `json:"sponsor"`
+}
+
+// TopicSubmission is the status of a photo submitted to a topic
+type TopicSubmission struct {
+	Status     *string    `json:"status"`
+	ApprovedOn *time.Time `json:"approved_on"`
+}
+
 // Photo represents a photo on unsplash.com
 type Photo struct {
-	ID             *string    `json:"id"`
-	CreatedAt      *time.Time `json:"created_at"`
-	UpdatedAt      *time.Time `json:"updated_at"`
-	Width          *int       `json:"width"`
-	Height         *int       `json:"height"`
-	Color          *string    `json:"color"`
-	Description    *string    `json:"description"`
-	AltDescription *string    `json:"alt_description"`
-	Views          *int       `json:"views"`
-	Downloads      *int       `json:"downloads"`
-	Likes          *int       `json:"likes"`
-	LikedByUser    *bool      `json:"liked_by_user"`
-	Exif           *ExifData  `json:"exif"`
-	Photographer   *User      `json:"user"`
-	Location       *struct {
-		Title    *string `json:"title"`
-		Name     *string `json:"name"`
-		City     *string `json:"city"`
-		Country  *string `json:"country"`
-		Position *struct {
-			Latitude  *float64 `json:"latitude"`
-			Longitude *float64 `json:"longitude"`
-		} `json:"position"`
-	} `json:"location"`
-	Tags                   *[]Tag        `json:"tags"`
-	CurrentUserCollections *[]Collection `json:"current_user_collections"`
-	Urls                   *struct {
-		Raw     *URL `json:"raw"`
-		Full    *URL `json:"full"`
-		Regular *URL `json:"regular"`
-		Small   *URL `json:"small"`
-		Thumb   *URL `json:"thumb"`
-		Custom  *URL `json:"custom"`
-	} `json:"urls"`
-	Links *struct {
-		Self             *URL `json:"self"`
-		HTML             *URL `json:"html"`
-		Download         *URL `json:"download"`
-		DownloadLocation *URL `json:"download_location"`
-	} `json:"links"`
+	ID                     *string                    `json:"id"`
+	Slug                   *string                    `json:"slug"`
+	AlternativeSlugs       map[string]string          `json:"alternative_slugs"`
+	CreatedAt              *time.Time                 `json:"created_at"`
+	UpdatedAt              *time.Time                 `json:"updated_at"`
+	PromotedAt             *time.Time                 `json:"promoted_at"`
+	Width                  *int                       `json:"width"`
+	Height                 *int                       `json:"height"`
+	Color                  *string                    `json:"color"`
+	BlurHash               *string                    `json:"blur_hash"`
+	Description            *string                    `json:"description"`
+	AltDescription         *string                    `json:"alt_description"`
+	Views                  *int                       `json:"views"`
+	Downloads              *int                       `json:"downloads"`
+	Likes                  *int                       `json:"likes"`
+	LikedByUser            *bool                      `json:"liked_by_user"`
+	Premium                *bool                      `json:"premium"`
+	Plus                   *bool                      `json:"plus"`
+	AssetType              *string                    `json:"asset_type"`
+	Exif                   *ExifData                  `json:"exif"`
+	Photographer           *User                      `json:"user"`
+	Location               *Location                  `json:"location"`
+	Tags                   *[]Tag                     `json:"tags"`
+	CurrentUserCollections *[]Collection              `json:"current_user_collections"`
+	Sponsorship            *Sponsorship               `json:"sponsorship"`
+	TopicSubmissions       map[string]TopicSubmission `json:"topic_submissions"`
+	Urls                   *PhotoUrls                 `json:"urls"`
+	Links                  *PhotoLinks                `json:"links"`
 }
 
 func (p *Photo) String() string {
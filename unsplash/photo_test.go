@@ -0,0 +1,117 @@
+// Copyright (c) 2017 Hardik Bagdi <hbagdi1@binghamton.edu>
+//
+// MIT License
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package unsplash
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const fullPhotoJSON = `{
+  "id": "eOLpJytrbsQ",
+  "slug": "a-man-drinking-a-coffee-eOLpJytrbsQ",
+  "alternative_slugs": {"en": "a-man-drinking-a-coffee-eOLpJytrbsQ", "es": "un-hombre-bebiendo-un-cafe-eOLpJytrbsQ"},
+  "created_at": "2014-11-18T14:35:36-05:00",
+  "promoted_at": "2014-11-19T10:00:00-05:00",
+  "width": 4000,
+  "height": 3000,
+  "blur_hash": "LEHV6nWB2yk8pyo0adR*.7kCMdnj",
+  "premium": false,
+  "plus": true,
+  "asset_type": "photo",
+  "location": {
+    "name": "Montreal, Canada",
+    "city": "Montreal",
+    "country": "Canada",
+    "position": {"latitude": 45.473298, "longitude": -73.638488}
+  },
+  "sponsorship": {
+    "impression_urls": ["https://secure.insightexpressai.com/adServer/adServerESI.aspx?script=false"],
+    "tagline": "Made to Change",
+    "tagline_url": "https://example.com/",
+    "sponsor": {"id": "sponsor", "username": "sponsor"}
+  },
+  "topic_submissions": {
+    "wallpapers": {"status": "approved", "approved_on": "2020-04-06T14:20:14Z"}
+  },
+  "user": {
+    "id": "pXhwzz1JtQU",
+    "username": "poorkane",
+    "name": "Gilbert Kane",
+    "for_hire": true,
+    "total_promoted_photos": 3,
+    "social": {"instagram_username": "gilbertkane", "portfolio_url": "https://example.com/kane"}
+  },
+  "urls": {
+    "raw": "https://images.unsplash.com/face-springmorning.jpg",
+    "small_s3": "https://s3.us-west-2.amazonaws.com/images.unsplash.com/small/face-springmorning.jpg"
+  },
+  "links": {
+    "html": "https://unsplash.com/photos/eOLpJytrbsQ",
+    "download_location": "https://api.unsplash.com/photos/eOLpJytrbsQ/download?ixid=gopher"
+  }
+}`
+
+func TestPhotoModel(T *testing.T) {
+	assert := assert.New(T)
+	var photo Photo
+	assert.Nil(json.Unmarshal([]byte(fullPhotoJSON), &photo))
+
+	assert.Equal("a-man-drinking-a-coffee-eOLpJytrbsQ", *photo.Slug)
+	assert.Equal("un-hombre-bebiendo-un-cafe-eOLpJytrbsQ", photo.AlternativeSlugs["es"])
+	assert.Equal(19, photo.PromotedAt.Day())
+	assert.Equal("LEHV6nWB2yk8pyo0adR*.7kCMdnj", *photo.BlurHash)
+	assert.Equal(false, *photo.Premium)
+	assert.Equal(true, *photo.Plus)
+	assert.Equal("photo", *photo.AssetType)
+
+	assert.Equal("Montreal", *photo.Location.City)
+	assert.Equal(-73.638488, *photo.Location.Position.Longitude)
+
+	assert.Equal("Made to Change", *photo.Sponsorship.Tagline)
+	assert.Equal("secure.insightexpressai.com", (*photo.Sponsorship.ImpressionUrls)[0].Host)
+	assert.Equal("sponsor", *photo.Sponsorship.Sponsor.Username)
+
+	assert.Equal("approved", *photo.TopicSubmissions["wallpapers"].Status)
+	assert.Equal(2020, photo.TopicSubmissions["wallpapers"].ApprovedOn.Year())
+
+	assert.Equal(true, *photo.Photographer.ForHire)
+	assert.Equal(3, *photo.Photographer.TotalPromotedPhotos)
+	assert.Equal("gilbertkane", *photo.Photographer.Social.InstagramUsername)
+	assert.Equal("/kane", photo.Photographer.Social.PortfolioURL.Path)
+
+	assert.Equal("/images.unsplash.com/small/face-springmorning.jpg", photo.Urls.SmallS3.Path)
+	assert.Equal("ixid=gopher", photo.Links.DownloadLocation.RawQuery)
+
+	//the named types can be used to build photos
+	latitude, longitude := 42.1, -75.9
+	built := Photo{Location: &Location{Position: &Position{Latitude: &latitude, Longitude: &longitude}}}
+	data, err := json.Marshal(built)
+	assert.Nil(err)
+	var decoded Photo
+	assert.Nil(json.Unmarshal(data, &decoded))
+	assert.Equal(latitude, *decoded.Location.Position.Latitude)
+}
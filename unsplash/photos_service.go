@@ -25,9 +25,13 @@ package unsplash
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"net/http"
 	"strconv"
+	"strings"
 )
 
 // PhotosService interacts with /photos endpoint
@@ -70,6 +74,11 @@ func processPhotoOpt(photoOpt *PhotoOpt) interface{} {
 
 // Photo return a photo with id
 func (ps *PhotosService) Photo(id string, photoOpt *PhotoOpt) (*Photo, *Response, error) {
+	return ps.PhotoWithContext(context.Background(), id, photoOpt)
+}
+
+// PhotoWithContext is like Photo but uses ctx for the request.
+func (ps *PhotosService) PhotoWithContext(ctx context.Context, id string, photoOpt *PhotoOpt) (*Photo, *Response, error) {
 	if "" == id {
 		return nil, nil, &IllegalArgumentError{ErrString: "Photo ID cannot be null"}
 	}
@@ -84,7 +93,7 @@ func (ps *PhotosService) Photo(id string, photoOpt *PhotoOpt) (*Photo, *Response
 		opt = processPhotoOpt(photoOpt)
 	}
 	endpoint := fmt.Sprintf("%v/%v", getEndpoint(photos), id)
-	req, err := newRequest(GET, endpoint, opt, nil)
+	req, err := ps.client.newRequest(ctx, GET, endpoint, opt, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -102,11 +111,16 @@ func (ps *PhotosService) Photo(id string, photoOpt *PhotoOpt) (*Photo, *Response
 
 // Stats return a stats about a photo with id.
 func (ps *PhotosService) Stats(id string) (*PhotoStats, *Response, error) {
+	return ps.StatsWithContext(context.Background(), id)
+}
+
+// StatsWithContext is like Stats but uses ctx for the request.
+func (ps *PhotosService) StatsWithContext(ctx context.Context, id string) (*PhotoStats, *Response, error) {
 	if "" == id {
 		return nil, nil, &IllegalArgumentError{ErrString: "Photo ID cannot be null"}
 	}
 	endpoint := fmt.Sprintf("%v/%v/stats", getEndpoint(photos), id)
-	req, err := newRequest(GET, endpoint, nil, nil)
+	req, err := ps.client.newRequest(ctx, GET, endpoint, nil, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -124,6 +138,11 @@ func (ps *PhotosService) Stats(id string) (*PhotoStats, *Response, error) {
 
 // Statistics return a stats about a photo with id.
 func (ps *PhotosService) Statistics(id string, opt *StatsOpt) (*PhotoStatistics, *Response, error) {
+	return ps.StatisticsWithContext(context.Background(), id, opt)
+}
+
+// StatisticsWithContext is like Statistics but uses ctx for the request.
+func (ps *PhotosService) StatisticsWithContext(ctx context.Context, id string, opt *StatsOpt) (*PhotoStatistics, *Response, error) {
 	if "" == id {
 		return nil, nil, &IllegalArgumentError{ErrString: "Photo ID cannot be null"}
 	}
@@ -134,7 +153,7 @@ func (ps *PhotosService) Statistics(id string, opt *StatsOpt) (*PhotoStatistics,
 		return nil, nil, &InvalidStatsOptError{ErrString: "opt provided is not valid."}
 	}
 	endpoint := fmt.Sprintf("%v/%v/statistics", getEndpoint(photos), id)
-	req, err := newRequest(GET, endpoint, opt, nil)
+	req, err := ps.client.newRequest(ctx, GET, endpoint, opt, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -152,11 +171,149 @@ func (ps *PhotosService) Statistics(id string, opt *StatsOpt) (*PhotoStatistics,
 
 // DownloadLink return the download URL for a photo.
 func (ps *PhotosService) DownloadLink(id string) (*URL, *Response, error) {
+	return ps.DownloadLinkWithContext(context.Background(), id)
+}
+
+// DownloadLinkWithContext is like DownloadLink but uses ctx for the request.
+func (ps *PhotosService) DownloadLinkWithContext(ctx context.Context, id string) (*URL, *Response, error) {
 	if "" == id {
 		return nil, nil, &IllegalArgumentError{ErrString: "Photo ID cannot be null"}
 	}
 	endpoint := fmt.Sprintf("%v/%v/download", getEndpoint(photos), id)
-	req, err := newRequest(GET, endpoint, nil, nil)
+	req, err := ps.client.newRequest(ctx, GET, endpoint, nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	resp, err := ps.client.do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	var url urlWrapper
+	err = json.Unmarshal(*resp.body, &url)
+	if err != nil {
+		return nil, nil, err
+	}
+	return url.URL, resp, nil
+}
+
+// PhotoLocationUpdate is the location of a photo in a PhotoUpdateOpt.
+// Latitude and Longitude must be set together.
+type PhotoLocationUpdate struct {
+	Latitude     *float64 `url:"latitude,omitempty"`
+	Longitude    *float64 `url:"longitude,omitempty"`
+	Name         *string  `url:"name,omitempty"`
+	City         *string  `url:"city,omitempty"`
+	Country      *string  `url:"country,omitempty"`
+	Confidential *bool    `url:"confidential,omitempty"`
+}
+
+// PhotoExifUpdate is the EXIF data of a photo in a PhotoUpdateOpt.
+type PhotoExifUpdate struct {
+	Make         *string `url:"make,omitempty"`
+	Model        *string `url:"model,omitempty"`
+	ExposureTime *string `url:"exposure_time,omitempty"`
+	Aperture     *string `url:"aperture_value,omitempty"`
+	FocalLength  *string `url:"focal_length,omitempty"`
+	Iso          *int    `url:"iso_speed_ratings,omitempty"`
+}
+
+// PhotoUpdateOpt lists the fields of a photo that can be updated.
+// Fields left nil are not changed.
+type PhotoUpdateOpt struct {
+	Description   *string              `url:"description,omitempty"`
+	ShowOnProfile *bool                `url:"show_on_profile,omitempty"`
+	Tags          []string             `url:"tags,comma,omitempty"`
+	Location      *PhotoLocationUpdate `url:"location,omitempty"`
+	Exif          *PhotoExifUpdate     `url:"exif,omitempty"`
+}
+
+// Valid validates a PhotoUpdateOpt
+func (opt *PhotoUpdateOpt) Valid() bool {
+	if opt.Description == nil && opt.ShowOnProfile == nil && opt.Tags == nil &&
+		opt.Location == nil && opt.Exif == nil {
+		return false
+	}
+	for _, tag := range opt.Tags {
+		if strings.TrimSpace(tag) == "" {
+			return false
+		}
+	}
+	if loc := opt.Location; loc != nil {
+		if (loc.Latitude == nil) != (loc.Longitude == nil) {
+			return false
+		}
+		if loc.Latitude != nil && (*loc.Latitude < -90 || *loc.Latitude > 90) {
+			return false
+		}
+		if loc.Longitude != nil && (*loc.Longitude < -180 || *loc.Longitude > 180) {
+			return false
+		}
+	}
+	if opt.Exif != nil && opt.Exif.Iso != nil && *opt.Exif.Iso < 0 {
+		return false
+	}
+	return true
+}
+
+// Update updates the photo with id on behalf of the authenticated user
+// and returns the updated Photo.
+// This requires the write_photos scope.
+func (ps *PhotosService) Update(id string, opt *PhotoUpdateOpt) (*Photo, *Response, error) {
+	return ps.UpdateWithContext(context.Background(), id, opt)
+}
+
+// UpdateWithContext is like Update but uses ctx for the request.
+func (ps *PhotosService) UpdateWithContext(ctx context.Context, id string, opt *PhotoUpdateOpt) (*Photo, *Response, error) {
+	if err := ps.client.requireScope(WritePhotos); err != nil {
+		return nil, nil, err
+	}
+	if "" == id {
+		return nil, nil, &IllegalArgumentError{ErrString: "Photo ID cannot be null"}
+	}
+	if nil == opt {
+		return nil, nil, &IllegalArgumentError{ErrString: "Opt cannot be nil"}
+	}
+	if !opt.Valid() {
+		return nil, nil, &InvalidPhotoOptError{ErrString: "opt provided is not valid."}
+	}
+	endpoint := fmt.Sprintf("%v/%v", getEndpoint(photos), id)
+	req, err := ps.client.newRequest(ctx, PUT, endpoint, opt, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	resp, err := ps.client.do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	var photo Photo
+	err = json.Unmarshal(*resp.body, &photo)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &photo, resp, nil
+}
+
+// TrackDownload triggers the download endpoint of photo, as required by the
+// API guidelines whenever a photo is used in an application.
+// photo.Links.DownloadLocation is requested as is, keeping its ixid
+// parameters. The returned URL points to the image file.
+// The download location must be on the API host, otherwise an
+// IllegalArgumentError is returned and the credentials are not sent.
+func (ps *PhotosService) TrackDownload(photo *Photo) (*URL, *Response, error) {
+	return ps.TrackDownloadWithContext(context.Background(), photo)
+}
+
+// TrackDownloadWithContext is like TrackDownload but uses ctx for the request.
+func (ps *PhotosService) TrackDownloadWithContext(ctx context.Context, photo *Photo) (*URL, *Response, error) {
+	if photo == nil || photo.Links == nil || photo.Links.DownloadLocation == nil ||
+		photo.Links.DownloadLocation.URL == nil {
+		return nil, nil, &IllegalArgumentError{ErrString: "Photo has no download location"}
+	}
+	if !ps.client.isAPIURL(photo.Links.DownloadLocation.URL) {
+		return nil, nil, &IllegalArgumentError{ErrString: "Download location is not on the API host"}
+	}
+	location := photo.Links.DownloadLocation.String()
+	req, err := buildRequest(ctx, "", GET, location, nil, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -172,18 +329,78 @@ func (ps *PhotosService) DownloadLink(id string) (*URL, *Response, error) {
 	return url.URL, resp, nil
 }
 
+// Download writes the full size image of photo to w and returns the
+// number of bytes written.
+// If the client was created with WithDownloadTracking, the download is
+// tracked with TrackDownload first.
+// The image is requested without credentials, see WithImageClient.
+func (ps *PhotosService) Download(photo *Photo, w io.Writer) (int64, error) {
+	return ps.DownloadWithContext(context.Background(), photo, w)
+}
+
+// DownloadWithContext is like Download but uses ctx for the requests.
+func (ps *PhotosService) DownloadWithContext(ctx context.Context, photo *Photo, w io.Writer) (int64, error) {
+	if photo == nil || photo.Urls == nil || photo.Urls.Full == nil || photo.Urls.Full.URL == nil {
+		return 0, &IllegalArgumentError{ErrString: "Photo has no image URL"}
+	}
+	if w == nil {
+		return 0, &IllegalArgumentError{ErrString: "Writer cannot be nil"}
+	}
+	if ps.client.trackDownloads {
+		if _, _, err := ps.TrackDownloadWithContext(ctx, photo); err != nil {
+			return 0, err
+		}
+	}
+	rawResp, err := ps.client.getImage(ctx, photo.Urls.Full, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer rawResp.Body.Close()
+	if rawResp.StatusCode != http.StatusOK {
+		return 0, &ErrorResponse{
+			Response:   rawResp,
+			StatusCode: rawResp.StatusCode,
+			RequestURL: photo.Urls.Full.String(),
+		}
+	}
+	return io.Copy(w, rawResp.Body)
+}
+
 // All returns a list of all photos on unsplash.
 // Note that some fields in photo structs from this result will be missing.
 // Use Photo() method to get all details of the  Photo.
 func (ps *PhotosService) All(listOpt *ListOpt) (*[]Photo, *Response, error) {
+	return ps.AllWithContext(context.Background(), listOpt)
+}
+
+// AllWithContext is like All but uses ctx for the request.
+func (ps *PhotosService) AllWithContext(ctx context.Context, listOpt *ListOpt) (*[]Photo, *Response, error) {
+	s := (service)(*ps)
+	return s.getPhotos(ctx, listOpt, getEndpoint(photos))
+}
+
+// AllPager returns a PhotoPager over all photos on unsplash.
+// listOpt sets the first page and the page size.
+func (ps *PhotosService) AllPager(ctx context.Context, listOpt *ListOpt, pagerOpt *PagerOpt) *PhotoPager {
 	s := (service)(*ps)
-	return s.getPhotos(listOpt, getEndpoint(photos))
+	return s.photoPager(ctx, listOpt, getEndpoint(photos), pagerOpt)
 }
 
 // Curated return a list of all curated photos.
 func (ps *PhotosService) Curated(listOpt *ListOpt) (*[]Photo, *Response, error) {
+	return ps.CuratedWithContext(context.Background(), listOpt)
+}
+
+// CuratedWithContext is like Curated but uses ctx for the request.
+func (ps *PhotosService) CuratedWithContext(ctx context.Context, listOpt *ListOpt) (*[]Photo, *Response, error) {
 	s := (service)(*ps)
-	return s.getPhotos(listOpt, getEndpoint(photos)+"/curated")
+	return s.getPhotos(ctx, listOpt, getEndpoint(photos)+"/curated")
+}
+
+// CuratedPager returns a PhotoPager over all curated photos.
+func (ps *PhotosService) CuratedPager(ctx context.Context, listOpt *ListOpt, pagerOpt *PagerOpt) *PhotoPager {
+	s := (service)(*ps)
+	return s.photoPager(ctx, listOpt, getEndpoint(photos)+"/curated", pagerOpt)
 }
 
 // RandomPhotoOpt optional parameters for a random photo search
@@ -212,10 +429,8 @@ func (opt *RandomPhotoOpt) Valid() bool {
 	if opt.Count == 0 {
 		opt.Count = 1
 	}
-	if opt.Orientation != "" {
-		if opt.Orientation != Landscape && opt.Orientation != Portrait && opt.Orientation != Squarish {
-			return false
-		}
+	if opt.Orientation != "" && !opt.Orientation.valid() {
+		return false
 	}
 
 	return true
@@ -231,18 +446,27 @@ const (
 	Squarish  orientation = "squarish"
 )
 
+func (o orientation) valid() bool {
+	return o == Landscape || o == Portrait || o == Squarish
+}
+
 var defaultRandomPhotoOpt = &RandomPhotoOpt{Count: 1}
 
 // Random returns random photo(s).
 // If opt is nil, then a single random photo is returned by default
 func (ps *PhotosService) Random(opt *RandomPhotoOpt) (*[]Photo, *Response, error) {
+	return ps.RandomWithContext(context.Background(), opt)
+}
+
+// RandomWithContext is like Random but uses ctx for the request.
+func (ps *PhotosService) RandomWithContext(ctx context.Context, opt *RandomPhotoOpt) (*[]Photo, *Response, error) {
 	if opt == nil {
 		opt = defaultRandomPhotoOpt
 	}
 	if !opt.Valid() {
 		return nil, nil, &InvalidListOptError{ErrString: "opt provided is not valid."}
 	}
-	req, err := newRequest(GET, getEndpoint(photos)+"/random", opt, nil)
+	req, err := ps.client.newRequest(ctx, GET, getEndpoint(photos)+"/random", opt, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -261,11 +485,19 @@ func (ps *PhotosService) Random(opt *RandomPhotoOpt) (*[]Photo, *Response, error
 
 // Like likes a photo on the currently authenticated user's behalf
 func (ps *PhotosService) Like(photoID string) (*Photo, *Response, error) {
+	return ps.LikeWithContext(context.Background(), photoID)
+}
+
+// LikeWithContext is like Like but uses ctx for the request.
+func (ps *PhotosService) LikeWithContext(ctx context.Context, photoID string) (*Photo, *Response, error) {
+	if err := ps.client.requireScope(WriteLikes); err != nil {
+		return nil, nil, err
+	}
 	if photoID == "" {
 		return nil, nil, &IllegalArgumentError{ErrString: "PhotoID cannot be null"}
 	}
 	endpoint := fmt.Sprintf("%v/%v/like", getEndpoint(photos), photoID)
-	req, err := newRequest(POST, endpoint, nil, nil)
+	req, err := ps.client.newRequest(ctx, POST, endpoint, nil, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -283,11 +515,19 @@ func (ps *PhotosService) Like(photoID string) (*Photo, *Response, error) {
 
 // Unlike likes a photo on the currently authenticated user's behalf
 func (ps *PhotosService) Unlike(photoID string) (*Photo, *Response, error) {
+	return ps.UnlikeWithContext(context.Background(), photoID)
+}
+
+// UnlikeWithContext is like Unlike but uses ctx for the request.
+func (ps *PhotosService) UnlikeWithContext(ctx context.Context, photoID string) (*Photo, *Response, error) {
+	if err := ps.client.requireScope(WriteLikes); err != nil {
+		return nil, nil, err
+	}
 	if photoID == "" {
 		return nil, nil, &IllegalArgumentError{ErrString: "PhotoID cannot be null"}
 	}
 	endpoint := fmt.Sprintf("%v/%v/like", getEndpoint(photos), photoID)
-	req, err := newRequest(DELETE, endpoint, nil, nil)
+	req, err := ps.client.newRequest(ctx, DELETE, endpoint, nil, nil)
 	if err != nil {
 		return nil, nil, err
 	}
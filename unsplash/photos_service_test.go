@@ -24,9 +24,13 @@
 package unsplash
 
 import (
+	"bytes"
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"log"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/jarcoal/httpmock"
@@ -395,3 +399,204 @@ func TestPhotoServiceRogueStuff(T *testing.T) {
 	roguePhotoServiceTest(T, httpmock.NewStringResponder(200, `Bad ass Bug flow`))
 	roguePhotoServiceTest(T, nil)
 }
+
+// downloadServer serves a download_location endpoint and an image file,
+// recording the URI of every request it gets.
+func downloadServer(uris *[]string) *httptest.Server {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*uris = append(*uris, r.URL.RequestURI())
+		switch r.URL.Path {
+		case "/photos/gopherPhoto/download":
+			fmt.Fprintf(w, `{"url": "%v/images/gopher.jpg"}`, server.URL)
+		case "/images/gopher.jpg":
+			fmt.Fprint(w, "gopher")
+		default:
+			w.WriteHeader(404)
+		}
+	}))
+	return server
+}
+
+func downloadablePhoto(serverURL string) *Photo {
+	var photo Photo
+	data := fmt.Sprintf(`{"id": "gopherPhoto",
+		"urls": {"full": "%v/images/gopher.jpg"},
+		"links": {"download_location": "%v/photos/gopherPhoto/download?ixid=gopher123"}}`,
+		serverURL, serverURL)
+	if err := json.Unmarshal([]byte(data), &photo); err != nil {
+		panic(err)
+	}
+	return &photo
+}
+
+func TestTrackDownload(T *testing.T) {
+	assert := assert.New(T)
+	log.SetOutput(ioutil.Discard)
+	var uris []string
+	server := downloadServer(&uris)
+	defer server.Close()
+	photo := downloadablePhoto(server.URL)
+
+	unsplash := New(nil, WithBaseURL(server.URL))
+	url, resp, err := unsplash.Photos.TrackDownload(photo)
+	assert.Nil(err)
+	assert.NotNil(resp)
+	assert.Equal(server.URL+"/images/gopher.jpg", url.String())
+	assert.Equal([]string{"/photos/gopherPhoto/download?ixid=gopher123"}, uris)
+
+	_, _, err = unsplash.Photos.TrackDownload(&Photo{})
+	assert.NotNil(err)
+	_, ok := err.(*IllegalArgumentError)
+	assert.Equal(true, ok)
+
+	//downloads are not tracked by default
+	uris = nil
+	var buf bytes.Buffer
+	n, err := unsplash.Photos.Download(photo, &buf)
+	assert.Nil(err)
+	assert.Equal(int64(6), n)
+	assert.Equal("gopher", buf.String())
+	assert.Equal([]string{"/images/gopher.jpg"}, uris)
+
+	uris = nil
+	buf.Reset()
+	unsplash = New(nil, WithBaseURL(server.URL), WithDownloadTracking())
+	_, err = unsplash.Photos.Download(photo, &buf)
+	assert.Nil(err)
+	assert.Equal("gopher", buf.String())
+	assert.Equal([]string{"/photos/gopherPhoto/download?ixid=gopher123", "/images/gopher.jpg"}, uris)
+
+	photo.Urls.Full.Path = "/images/missing.jpg"
+	_, err = unsplash.Photos.Download(photo, &buf)
+	assert.NotNil(err)
+	errResp, ok := err.(*ErrorResponse)
+	assert.Equal(true, ok)
+	assert.Equal(404, errResp.StatusCode)
+}
+
+// bearerTransport adds a bearer token to every request, like an OAuth2
+// client does.
+type bearerTransport struct{}
+
+func (bearerTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	r = r.Clone(r.Context())
+	r.Header.Set("Authorization", "Bearer secret")
+	return http.DefaultTransport.RoundTrip(r)
+}
+
+func TestDownloadCredentials(T *testing.T) {
+	assert := assert.New(T)
+	log.SetOutput(ioutil.Discard)
+	var uris []string
+	var auth []string
+	foreign := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		uris = append(uris, r.URL.RequestURI())
+		auth = append(auth, r.Header.Get("Authorization"))
+		fmt.Fprint(w, "gopher")
+	}))
+	defer foreign.Close()
+	var apiURIs []string
+	api := downloadServer(&apiURIs)
+	defer api.Close()
+	photo := downloadablePhoto(foreign.URL)
+
+	client := &http.Client{Transport: bearerTransport{}}
+	unsplash := NewWithClientID(client, "key", WithBaseURL(api.URL))
+	// download locations off the API host are not requested
+	_, _, err := unsplash.Photos.TrackDownload(photo)
+	_, ok := err.(*IllegalArgumentError)
+	assert.Equal(true, ok)
+	assert.Equal(0, len(uris))
+
+	// images are requested without credentials
+	var buf bytes.Buffer
+	_, err = unsplash.Photos.Download(photo, &buf)
+	assert.Nil(err)
+	assert.Equal("gopher", buf.String())
+	assert.Equal([]string{"/images/gopher.jpg"}, uris)
+	assert.Equal([]string{""}, auth)
+	assert.Equal(0, len(apiURIs))
+}
+
+func TestPhotoUpdateOpt(T *testing.T) {
+	assert := assert.New(T)
+	var opt PhotoUpdateOpt
+	assert.Equal(false, opt.Valid())
+	description := "gopher"
+	opt.Description = &description
+	assert.Equal(true, opt.Valid())
+	opt.Tags = []string{"go", " "}
+	assert.Equal(false, opt.Valid())
+	opt.Tags = []string{"go", "gopher"}
+	assert.Equal(true, opt.Valid())
+
+	latitude, longitude := 91.0, 10.0
+	opt.Location = &PhotoLocationUpdate{Latitude: &latitude}
+	assert.Equal(false, opt.Valid())
+	opt.Location.Longitude = &longitude
+	assert.Equal(false, opt.Valid())
+	latitude = 45.0
+	assert.Equal(true, opt.Valid())
+
+	iso := -100
+	opt.Exif = &PhotoExifUpdate{Iso: &iso}
+	assert.Equal(false, opt.Valid())
+	iso = 100
+	assert.Equal(true, opt.Valid())
+}
+
+func TestUpdatePhoto(T *testing.T) {
+	assert := assert.New(T)
+	log.SetOutput(ioutil.Discard)
+	var method, query string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, query = r.Method, r.URL.Query().Encode()
+		if r.URL.Path != "/photos/gopherPhoto" {
+			w.WriteHeader(404)
+			return
+		}
+		fmt.Fprint(w, `{"id": "gopherPhoto", "description": "A gopher"}`)
+	}))
+	defer server.Close()
+	unsplash := New(nil, WithBaseURL(server.URL))
+
+	description, hidden, city := "A gopher", false, "Binghamton"
+	latitude, longitude := 42.1, -75.9
+	iso := 200
+	opt := &PhotoUpdateOpt{
+		Description:   &description,
+		ShowOnProfile: &hidden,
+		Tags:          []string{"go", "gopher"},
+		Location:      &PhotoLocationUpdate{Latitude: &latitude, Longitude: &longitude, City: &city},
+		Exif:          &PhotoExifUpdate{Iso: &iso},
+	}
+	photo, resp, err := unsplash.Photos.Update("gopherPhoto", opt)
+	assert.Nil(err)
+	assert.NotNil(resp)
+	assert.Equal("A gopher", *photo.Description)
+	assert.Equal("PUT", method)
+	assert.Equal("description=A+gopher&exif%5Biso_speed_ratings%5D=200"+
+		"&location%5Bcity%5D=Binghamton&location%5Blatitude%5D=42.1&location%5Blongitude%5D=-75.9"+
+		"&show_on_profile=false&tags=go%2Cgopher", query)
+
+	_, _, err = unsplash.Photos.Update("gopherPhoto", &PhotoUpdateOpt{})
+	assert.NotNil(err)
+	_, ok := err.(*InvalidPhotoOptError)
+	assert.Equal(true, ok)
+
+	_, _, err = unsplash.Photos.Update("", opt)
+	assert.NotNil(err)
+	_, ok = err.(*IllegalArgumentError)
+	assert.Equal(true, ok)
+
+	_, _, err = unsplash.Photos.Update("gopherPhoto", nil)
+	assert.NotNil(err)
+	_, ok = err.(*IllegalArgumentError)
+	assert.Equal(true, ok)
+
+	_, _, err = unsplash.Photos.Update("missing", opt)
+	assert.NotNil(err)
+	_, ok = err.(*NotFoundError)
+	assert.Equal(true, ok)
+}
@@ -0,0 +1,175 @@
+// Copyright (c) 2017 Hardik Bagdi <hbagdi1@binghamton.edu>
+//
+// MIT License
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package unsplash
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RateLimiter keeps track of the API quota left for an Unsplash client,
+// based on the X-Ratelimit-* headers returned by the API.
+// A RateLimiter is shared by all services of the client it is attached to
+// and holds back requests before the quota is exhausted.
+// It is safe for concurrent use.
+type RateLimiter struct {
+	// Reserve is the number of requests kept in hand. Requests are held back
+	// once the remaining quota drops to Reserve.
+	Reserve int
+	// Block makes requests wait until the quota window resets instead of
+	// failing with a RateLimitError.
+	Block bool
+	// Window is the length of the quota window. Defaults to an hour.
+	Window time.Duration
+
+	mu        sync.Mutex
+	known     bool
+	limit     int
+	remaining int
+	reset     time.Time
+}
+
+// RateLimitState is a snapshot of the quota tracked by a RateLimiter.
+type RateLimitState struct {
+	// Known is false until the first response carrying rate limit
+	// headers is seen, or after the quota window has passed.
+	Known bool
+	// Limit is the number of requests allowed per window.
+	Limit int
+	// Remaining is the estimated number of requests left in the window.
+	Remaining int
+	// Reset is the estimated time the current window ends.
+	Reset time.Time
+}
+
+// WithRateLimiter attaches limiter to the client.
+// The same RateLimiter should not be shared between clients using
+// different API keys.
+func WithRateLimiter(limiter *RateLimiter) Option {
+	return func(u *Unsplash) {
+		u.rateLimiter = limiter
+	}
+}
+
+// State returns the current quota state.
+func (l *RateLimiter) State() RateLimitState {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.expire(time.Now())
+	return RateLimitState{
+		Known:     l.known,
+		Limit:     l.limit,
+		Remaining: l.remaining,
+		Reset:     l.reset,
+	}
+}
+
+func (l *RateLimiter) window() time.Duration {
+	if l.Window <= 0 {
+		return time.Hour
+	}
+	return l.Window
+}
+
+// expire forgets the quota once the window it was observed in has passed.
+// Must be called with l.mu held.
+func (l *RateLimiter) expire(now time.Time) {
+	if l.known && !now.Before(l.reset) {
+		l.known = false
+		l.remaining = l.limit
+	}
+}
+
+// wait takes one request from the quota, blocking or failing
+// if there is none left.
+func (l *RateLimiter) wait(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+	for {
+		l.mu.Lock()
+		l.expire(time.Now())
+		if !l.known || l.remaining > l.Reserve {
+			if l.known {
+				l.remaining--
+			}
+			l.mu.Unlock()
+			return nil
+		}
+		reset := l.reset
+		l.mu.Unlock()
+		if !l.Block {
+			return &RateLimitError{ErrString: "Rate limit exhausted, quota resets at " + reset.Format(time.RFC3339)}
+		}
+		if err := sleep(ctx, time.Until(reset)); err != nil {
+			return err
+		}
+	}
+}
+
+// update records the quota reported by the API in resp.
+func (l *RateLimiter) update(resp *http.Response) {
+	if l == nil || resp == nil {
+		return
+	}
+	limit, remaining, ok := parseRateLimits(resp.Header)
+	if !ok {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := time.Now()
+	l.expire(now)
+	if !l.known {
+		l.known = true
+		l.reset = now.Add(l.window())
+	}
+	l.limit = limit
+	l.remaining = remaining
+}
+
+// parseRateLimits reads the X-Ratelimit-Limit and X-Ratelimit-Remaining
+// headers. ok is false if either of them is missing or malformed.
+func parseRateLimits(h http.Header) (limit, remaining int, ok bool) {
+	maxLimit, found := h["X-Ratelimit-Limit"]
+	if !found || len(maxLimit) != 1 {
+		return 0, 0, false
+	}
+	rateRemaining, found := h["X-Ratelimit-Remaining"]
+	if !found || len(rateRemaining) != 1 {
+		return 0, 0, false
+	}
+	limit, err := strconv.Atoi(maxLimit[0])
+	if err != nil {
+		return 0, 0, false
+	}
+	remaining, err = strconv.Atoi(rateRemaining[0])
+	if err != nil {
+		return 0, 0, false
+	}
+	return limit, remaining, true
+}
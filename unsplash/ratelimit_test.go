@@ -0,0 +1,122 @@
+// Copyright (c) 2017 Hardik Bagdi <hbagdi1@binghamton.edu>
+//
+// MIT License
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package unsplash
+
+import (
+	"context"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func quotaServer(limit int) (*httptest.Server, *int) {
+	hits := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		remaining := limit - hits
+		if remaining < 0 {
+			remaining = 0
+		}
+		w.Header().Set("X-Ratelimit-Limit", strconv.Itoa(limit))
+		w.Header().Set("X-Ratelimit-Remaining", strconv.Itoa(remaining))
+		if hits > limit {
+			w.WriteHeader(403)
+			w.Write([]byte(`Rate Limit Exceeded`))
+			return
+		}
+		w.Write([]byte(`{"photos": 42}`))
+	}))
+	return server, &hits
+}
+
+func TestRateLimiter(T *testing.T) {
+	assert := assert.New(T)
+	log.SetOutput(ioutil.Discard)
+	server, hits := quotaServer(5)
+	defer server.Close()
+
+	limiter := &RateLimiter{Reserve: 1}
+	unsplash := New(nil, WithBaseURL(server.URL), WithRateLimiter(limiter))
+	assert.Equal(false, limiter.State().Known)
+
+	for i := 0; i < 4; i++ {
+		_, _, err := unsplash.TotalStats()
+		assert.Nil(err)
+	}
+	state := limiter.State()
+	assert.Equal(true, state.Known)
+	assert.Equal(5, state.Limit)
+	assert.Equal(1, state.Remaining)
+	assert.True(state.Reset.After(time.Now().Add(59 * time.Minute)))
+
+	// the reserved request never leaves the client
+	stats, resp, err := unsplash.TotalStats()
+	assert.Nil(stats)
+	assert.Nil(resp)
+	_, ok := err.(*RateLimitError)
+	assert.Equal(true, ok)
+	assert.Equal(4, *hits)
+
+	// other services share the same quota
+	photos, _, err := unsplash.Photos.All(nil)
+	assert.Nil(photos)
+	_, ok = err.(*RateLimitError)
+	assert.Equal(true, ok)
+	assert.Equal(4, *hits)
+}
+
+func TestRateLimiterBlock(T *testing.T) {
+	assert := assert.New(T)
+	log.SetOutput(ioutil.Discard)
+	server, hits := quotaServer(1)
+	defer server.Close()
+
+	limiter := &RateLimiter{Block: true, Window: 20 * time.Millisecond}
+	unsplash := New(nil, WithBaseURL(server.URL), WithRateLimiter(limiter))
+	_, _, err := unsplash.TotalStats()
+	assert.Nil(err)
+	assert.Equal(0, limiter.State().Remaining)
+
+	// waits for the window to pass, then lets the request through
+	start := time.Now()
+	_, _, err = unsplash.TotalStats()
+	assert.True(time.Since(start) >= 10*time.Millisecond)
+	assert.Equal(2, *hits)
+	_, ok := err.(*RateLimitError)
+	assert.Equal(true, ok)
+
+	// blocking honours the context
+	limiter.Window = time.Hour
+	_, _, _ = unsplash.TotalStats()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, _, err = unsplash.TotalStatsWithContext(ctx)
+	assert.Equal(context.DeadlineExceeded, err)
+}
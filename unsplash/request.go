@@ -25,6 +25,7 @@ package unsplash
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"net/http"
 
@@ -36,6 +37,20 @@ type request struct {
 }
 
 func newRequest(m method, e string, qs interface{}, body interface{}) (*request, error) {
+	return buildRequest(context.Background(), getEndpoint(base), m, e, qs, body)
+}
+
+// newRequest builds a request for endpoint e, resolved against the base URL
+// of this client. The request is bound to ctx; cancelling ctx aborts the
+// underlying HTTP call.
+func (s *Unsplash) newRequest(ctx context.Context, m method, e string, qs interface{}, body interface{}) (*request, error) {
+	return buildRequest(ctx, s.getBaseURL(), m, e, qs, body)
+}
+
+func buildRequest(ctx context.Context, baseURL string, m method, e string, qs interface{}, body interface{}) (*request, error) {
+	if ctx == nil {
+		return nil, &IllegalArgumentError{ErrString: "Context can't be nil."}
+	}
 	if e == "" {
 		return nil, &IllegalArgumentError{ErrString: "Endpoint can't be null."}
 	}
@@ -46,7 +61,7 @@ func newRequest(m method, e string, qs interface{}, body interface{}) (*request,
 	}
 	//Create a new request
 
-	httpRequest, err := http.NewRequest(string(m), getEndpoint(base)+e, bytes.NewBuffer(buf))
+	httpRequest, err := http.NewRequest(string(m), baseURL+e, bytes.NewBuffer(buf))
 
 	if err != nil {
 		return nil, err
@@ -60,7 +75,7 @@ func newRequest(m method, e string, qs interface{}, body interface{}) (*request,
 		httpRequest.URL.RawQuery = values.Encode()
 	}
 	req := new(request)
-	req.Request = httpRequest
+	req.Request = httpRequest.WithContext(ctx)
 	req.Request.Header.Add("Content-Type", "application/json")
 	return req, nil
 }
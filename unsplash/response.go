@@ -25,7 +25,6 @@ package unsplash
 
 import (
 	"bytes"
-	"errors"
 	"io/ioutil"
 	"net/http"
 	"net/url"
@@ -48,19 +47,22 @@ func (r *Response) checkForErrors() error {
 	switch r.httpResponse.StatusCode {
 	case 200, 201, 202, 204, 205:
 		return nil
+	}
+	errResp := newErrorResponse(r)
+	switch r.httpResponse.StatusCode {
 	case 401:
-		return &AuthorizationError{ErrString: errStringHelper(r.httpResponse.StatusCode, "Unauthorized request", r.body)}
+		return &AuthorizationError{ErrString: errStringHelper(r.httpResponse.StatusCode, "Unauthorized request", r.body), ErrorResponse: errResp}
 	case 403:
 		if r.RateLimitRemaining == 0 {
-			return &RateLimitError{ErrString: errStringHelper(r.httpResponse.StatusCode, "Rate limit exhausted", r.body)}
+			return &RateLimitError{ErrString: errStringHelper(r.httpResponse.StatusCode, "Rate limit exhausted", r.body), ErrorResponse: errResp}
 		}
 
-		return &AuthorizationError{ErrString: errStringHelper(r.httpResponse.StatusCode, "Access forbidden request", r.body)}
+		return &AuthorizationError{ErrString: errStringHelper(r.httpResponse.StatusCode, "Access forbidden request", r.body), ErrorResponse: errResp}
 
 	case 404:
-		return &NotFoundError{ErrString: errStringHelper(r.httpResponse.StatusCode, "The cat got tired of the Laser", r.body)}
+		return &NotFoundError{ErrString: errStringHelper(r.httpResponse.StatusCode, "The cat got tired of the Laser", r.body), ErrorResponse: errResp}
 	default:
-		return errors.New(errStringHelper(r.httpResponse.StatusCode, "API returned an error", r.body))
+		return errResp
 
 	}
 }
@@ -101,13 +103,10 @@ func newResponse(r *http.Response) (*Response, error) {
 
 func (r *Response) populateRateLimits() {
 	//fails silently
-	maxLimit, ok := r.httpResponse.Header["X-Ratelimit-Limit"]
-	if ok && len(maxLimit) == 1 {
-		r.RateLimit, _ = strconv.Atoi(maxLimit[0])
-	}
-	rateRemaining, ok := r.httpResponse.Header["X-Ratelimit-Remaining"]
-	if ok && len(rateRemaining) == 1 {
-		r.RateLimitRemaining, _ = strconv.Atoi(rateRemaining[0])
+	limit, remaining, ok := parseRateLimits(r.httpResponse.Header)
+	if ok {
+		r.RateLimit = limit
+		r.RateLimitRemaining = remaining
 	}
 }
 
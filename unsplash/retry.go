@@ -0,0 +1,195 @@
+// Copyright (c) 2017 Hardik Bagdi <hbagdi1@binghamton.edu>
+//
+// MIT License
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package unsplash
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controls how failed requests are retried.
+// A request is retried when the HTTP call fails with a network error or
+// when the API responds with one of RetryableStatus.
+// Only idempotent requests (GET, PUT, DELETE) are retried unless
+// RetryNonIdempotent is set.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	MaxAttempts int
+	// MinBackoff is the base wait before the first retry.
+	// It is doubled for every subsequent retry.
+	MinBackoff time.Duration
+	// MaxBackoff caps the wait between two attempts.
+	MaxBackoff time.Duration
+	// RetryableStatus lists HTTP status codes that are retried.
+	RetryableStatus []int
+	// RetryNonIdempotent allows retrying POST requests as well.
+	RetryNonIdempotent bool
+}
+
+// DefaultRetryPolicy returns a RetryPolicy that makes up to 3 attempts
+// with a backoff between 500ms and 10s and retries 500, 502, 503 and 504.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:     3,
+		MinBackoff:      500 * time.Millisecond,
+		MaxBackoff:      10 * time.Second,
+		RetryableStatus: []int{500, 502, 503, 504},
+	}
+}
+
+// WithRetryPolicy makes the client retry transient failures
+// according to policy. Requests are not retried by default.
+// The client keeps a copy of policy, so changing it afterwards has no
+// effect. If policy is not valid, every request fails with an
+// IllegalArgumentError.
+func WithRetryPolicy(policy *RetryPolicy) Option {
+	return func(u *Unsplash) {
+		u.retryPolicy = nil
+		u.retryErr = nil
+		if policy == nil {
+			return
+		}
+		if !policy.Valid() {
+			u.retryErr = &IllegalArgumentError{ErrString: "RetryPolicy provided is not valid."}
+			return
+		}
+		p := *policy
+		p.RetryableStatus = append([]int(nil), policy.RetryableStatus...)
+		u.retryPolicy = &p
+	}
+}
+
+// Valid validates a RetryPolicy.
+// A MaxAttempts of 0 is treated as 1, i.e. no retries.
+func (p *RetryPolicy) Valid() bool {
+	if p.MaxAttempts < 0 || p.MinBackoff < 0 || p.MaxBackoff < 0 {
+		return false
+	}
+	if p.MaxBackoff != 0 && p.MaxBackoff < p.MinBackoff {
+		return false
+	}
+	return true
+}
+
+// retry reports if another attempt should be made after attempt
+// finished with resp and err.
+func (p *RetryPolicy) retry(req *http.Request, resp *http.Response, err error, attempt int) bool {
+	if p == nil {
+		return false
+	}
+	maxAttempts := p.MaxAttempts
+	if maxAttempts == 0 {
+		maxAttempts = 1
+	}
+	if attempt >= maxAttempts {
+		return false
+	}
+	if !p.RetryNonIdempotent && !isIdempotent(req.Method) {
+		return false
+	}
+	if err != nil {
+		return true
+	}
+	for _, status := range p.RetryableStatus {
+		if resp.StatusCode == status {
+			return true
+		}
+	}
+	return false
+}
+
+// backoff returns how long to wait before the attempt following attempt.
+// It uses exponential backoff with full jitter; a Retry-After header
+// sent by the API takes precedence if it's within MaxBackoff.
+func (p *RetryPolicy) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		seconds, err := strconv.Atoi(resp.Header.Get("Retry-After"))
+		wait := time.Duration(seconds) * time.Second
+		if err == nil && wait > 0 && (p.MaxBackoff == 0 || wait <= p.MaxBackoff) {
+			return wait
+		}
+	}
+	wait := p.MinBackoff
+	for i := 1; i < attempt; i++ {
+		wait *= 2
+		if p.MaxBackoff != 0 && wait >= p.MaxBackoff {
+			break
+		}
+	}
+	if p.MaxBackoff != 0 && wait > p.MaxBackoff {
+		wait = p.MaxBackoff
+	}
+	if wait <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(wait) + 1))
+}
+
+func isIdempotent(m string) bool {
+	switch method(m) {
+	case GET, PUT, DELETE:
+		return true
+	}
+	return false
+}
+
+// sleep waits for d or until ctx is done, whichever happens first.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// discard drains and closes the body of a response that won't be used
+// so that the underlying connection can be reused.
+func discard(resp *http.Response) {
+	if resp == nil {
+		return
+	}
+	io.Copy(ioutil.Discard, resp.Body)
+	resp.Body.Close()
+}
+
+// rewind resets the body of req so that it can be sent again.
+func rewind(req *http.Request) error {
+	if req.GetBody == nil {
+		return nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return err
+	}
+	req.Body = body
+	return nil
+}
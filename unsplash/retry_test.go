@@ -0,0 +1,198 @@
+// Copyright (c) 2017 Hardik Bagdi <hbagdi1@binghamton.edu>
+//
+// MIT License
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package unsplash
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func flakyServer(failures int, status int) (*httptest.Server, *int) {
+	hits := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		if hits <= failures {
+			w.WriteHeader(status)
+			w.Write([]byte(`{"errors": ["Try again"]}`))
+			return
+		}
+		w.WriteHeader(201)
+		w.Write([]byte(`{"id": 4242, "title": "gopherCollection", "photos": 42}`))
+	}))
+	return server, &hits
+}
+
+func TestRetryPolicy(T *testing.T) {
+	assert := assert.New(T)
+	log.SetOutput(ioutil.Discard)
+
+	var policy RetryPolicy
+	assert.Equal(true, policy.Valid())
+	assert.Equal(0, policy.MaxAttempts)
+	assert.Equal(false, policy.retry(&http.Request{Method: "GET"}, nil, io.EOF, 1))
+	assert.Equal(false, (&RetryPolicy{MaxAttempts: -1}).Valid())
+	assert.Equal(false, (&RetryPolicy{MinBackoff: time.Second, MaxBackoff: time.Millisecond}).Valid())
+	assert.Equal(true, DefaultRetryPolicy().Valid())
+
+	policy = RetryPolicy{MinBackoff: time.Second, MaxBackoff: 4 * time.Second}
+	for attempt := 1; attempt < 10; attempt++ {
+		assert.True(policy.backoff(attempt, nil) <= 4*time.Second)
+	}
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"3"}}}
+	assert.Equal(3*time.Second, policy.backoff(1, resp))
+	resp.Header.Set("Retry-After", "3600")
+	assert.True(policy.backoff(1, resp) <= time.Second)
+}
+
+func TestRetry(T *testing.T) {
+	assert := assert.New(T)
+	log.SetOutput(ioutil.Discard)
+	policy := &RetryPolicy{
+		MaxAttempts:     3,
+		MinBackoff:      time.Millisecond,
+		MaxBackoff:      5 * time.Millisecond,
+		RetryableStatus: []int{502},
+	}
+
+	// recovers after two bad gateways
+	server, hits := flakyServer(2, 502)
+	defer server.Close()
+	unsplash := New(nil, WithBaseURL(server.URL), WithRetryPolicy(policy))
+	stats, resp, err := unsplash.TotalStats()
+	assert.Nil(err)
+	assert.NotNil(resp)
+	assert.Equal(uint64(42), stats.Photos)
+	assert.Equal(3, *hits)
+
+	// gives up after MaxAttempts
+	server, hits = flakyServer(5, 502)
+	defer server.Close()
+	unsplash = New(nil, WithBaseURL(server.URL), WithRetryPolicy(policy))
+	stats, resp, err = unsplash.TotalStats()
+	assert.NotNil(err)
+	assert.Nil(resp)
+	assert.Nil(stats)
+	assert.Equal(3, *hits)
+
+	// status codes outside of RetryableStatus are not retried
+	server, hits = flakyServer(1, 500)
+	defer server.Close()
+	unsplash = New(nil, WithBaseURL(server.URL), WithRetryPolicy(policy))
+	_, _, err = unsplash.TotalStats()
+	assert.NotNil(err)
+	assert.Equal(1, *hits)
+
+	// POST is not retried by default
+	server, hits = flakyServer(1, 502)
+	defer server.Close()
+	unsplash = New(nil, WithBaseURL(server.URL), WithRetryPolicy(policy))
+	title := "gopherCollection"
+	collection, _, err := unsplash.Collections.Create(&CollectionOpt{Title: &title})
+	assert.NotNil(err)
+	assert.Nil(collection)
+	assert.Equal(1, *hits)
+
+	// unless asked to
+	nonIdempotent := *policy
+	nonIdempotent.RetryNonIdempotent = true
+	server, hits = flakyServer(1, 502)
+	defer server.Close()
+	unsplash = New(nil, WithBaseURL(server.URL), WithRetryPolicy(&nonIdempotent))
+	collection, _, err = unsplash.Collections.Create(&CollectionOpt{Title: &title})
+	assert.Nil(err)
+	assert.NotNil(collection)
+	assert.Equal(2, *hits)
+
+	// no retries without a policy
+	server, hits = flakyServer(1, 502)
+	defer server.Close()
+	unsplash = New(nil, WithBaseURL(server.URL))
+	_, _, err = unsplash.TotalStats()
+	assert.NotNil(err)
+	assert.Equal(1, *hits)
+
+	// invalid policy
+	unsplash = New(nil, WithBaseURL(server.URL), WithRetryPolicy(&RetryPolicy{MaxAttempts: -1}))
+	_, _, err = unsplash.TotalStats()
+	_, ok := err.(*IllegalArgumentError)
+	assert.Equal(true, ok)
+}
+
+func TestRetryPolicyShared(T *testing.T) {
+	assert := assert.New(T)
+	log.SetOutput(ioutil.Discard)
+	var hits int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		w.Write([]byte(`{"downloads": 42}`))
+	}))
+	defer server.Close()
+	policy := &RetryPolicy{}
+	unsplash := New(nil, WithBaseURL(server.URL), WithRetryPolicy(policy))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _, err := unsplash.TotalStats()
+			assert.Nil(err)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(int32(4), atomic.LoadInt32(&hits))
+	assert.Equal(0, policy.MaxAttempts)
+
+	// the client keeps its own copy
+	policy.MaxAttempts = -1
+	_, _, err := unsplash.TotalStats()
+	assert.Nil(err)
+}
+
+func TestRetryContext(T *testing.T) {
+	assert := assert.New(T)
+	log.SetOutput(ioutil.Discard)
+	server, hits := flakyServer(5, 503)
+	defer server.Close()
+	policy := DefaultRetryPolicy()
+	policy.MinBackoff = time.Hour
+	policy.MaxBackoff = time.Hour
+	unsplash := New(nil, WithBaseURL(server.URL), WithRetryPolicy(policy))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, _, err := unsplash.TotalStatsWithContext(ctx)
+	assert.Equal(context.DeadlineExceeded, err)
+	assert.Equal(1, *hits)
+}
@@ -23,7 +23,10 @@
 
 package unsplash
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 // SearchService interacts with /search endpoint
 type SearchService service
@@ -50,15 +53,100 @@ func (opt *SearchOpt) Valid() bool {
 	return true
 }
 
+// Color is the dominant color of a photo
+type Color string
+
+// These constants show possible Color filters for a photo search
+const (
+	BlackAndWhite Color = "black_and_white"
+	Black         Color = "black"
+	White         Color = "white"
+	Yellow        Color = "yellow"
+	Orange        Color = "orange"
+	Red           Color = "red"
+	Purple        Color = "purple"
+	Magenta       Color = "magenta"
+	Green         Color = "green"
+	Teal          Color = "teal"
+	Blue          Color = "blue"
+)
+
+var colors = []Color{BlackAndWhite, Black, White, Yellow, Orange, Red, Purple, Magenta, Green, Teal, Blue}
+
+// ContentFilter is the level of content safety of a photo search
+type ContentFilter string
+
+// These constants show possible ContentFilter levels.
+// The API defaults to ContentFilterLow.
+const (
+	ContentFilterLow  ContentFilter = "low"
+	ContentFilterHigh ContentFilter = "high"
+)
+
+// Relevant should be used for OrderBy in PhotoSearchOpt.
+// Latest can be used as well.
+const Relevant = "relevant"
+
+// PhotoSearchOpt should be used to search for photos with filters.
+// Fields left empty are not sent and use the API defaults.
+type PhotoSearchOpt struct {
+	SearchOpt
+	OrderBy       string        `url:"order_by,omitempty"`
+	Orientation   orientation   `url:"orientation,omitempty"`
+	Color         Color         `url:"color,omitempty"`
+	ContentFilter ContentFilter `url:"content_filter,omitempty"`
+	CollectionIDs []int         `url:"collections,comma,omitempty"`
+	Lang          string        `url:"lang,omitempty"`
+}
+
+// Valid validates the query and the filters in a PhotoSearchOpt
+func (opt *PhotoSearchOpt) Valid() bool {
+	if !opt.SearchOpt.Valid() {
+		return false
+	}
+	if opt.OrderBy != "" && opt.OrderBy != Relevant && opt.OrderBy != Latest {
+		return false
+	}
+	if opt.Orientation != "" && !opt.Orientation.valid() {
+		return false
+	}
+	if opt.Color != "" {
+		valid := false
+		for _, c := range colors {
+			if c == opt.Color {
+				valid = true
+			}
+		}
+		if !valid {
+			return false
+		}
+	}
+	if opt.ContentFilter != "" && opt.ContentFilter != ContentFilterLow && opt.ContentFilter != ContentFilterHigh {
+		return false
+	}
+	//lang is an ISO 639-1 code
+	if opt.Lang != "" {
+		if len(opt.Lang) != 2 || opt.Lang[0] < 'a' || opt.Lang[0] > 'z' || opt.Lang[1] < 'a' || opt.Lang[1] > 'z' {
+			return false
+		}
+	}
+	return true
+}
+
 // Users can be used to query any endpoint which returns an array of users.
 func (ss *SearchService) Users(opt *SearchOpt) (*UserSearchResult, *Response, error) {
+	return ss.UsersWithContext(context.Background(), opt)
+}
+
+// UsersWithContext is like Users but uses ctx for the request.
+func (ss *SearchService) UsersWithContext(ctx context.Context, opt *SearchOpt) (*UserSearchResult, *Response, error) {
 	if nil == opt {
 		return nil, nil, &IllegalArgumentError{ErrString: "SearchOpt cannot be nil"}
 	}
 	if !opt.Valid() {
 		return nil, nil, &InvalidListOptError{ErrString: "Search query cannot be empty."}
 	}
-	req, err := newRequest(GET, getEndpoint(searchUsers), opt, nil)
+	req, err := ss.client.newRequest(ctx, GET, getEndpoint(searchUsers), opt, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -74,15 +162,75 @@ func (ss *SearchService) Users(opt *SearchOpt) (*UserSearchResult, *Response, er
 	return &users, resp, nil
 }
 
+// UsersPager returns a UserPager over all users matching the search.
+// opt sets the query, the first page and the page size.
+func (ss *SearchService) UsersPager(ctx context.Context, opt *SearchOpt, pagerOpt *PagerOpt) *UserPager {
+	if nil == opt {
+		return &UserPager{pager: pager{err: &IllegalArgumentError{ErrString: "SearchOpt cannot be nil"}}}
+	}
+	searchOpt := *opt
+	p := &UserPager{pager: newPager(ctx, searchOpt.Page, pagerOpt)}
+	p.fetch = func(ctx context.Context, page int) (*[]User, *Response, error) {
+		pageOpt := searchOpt
+		pageOpt.Page = page
+		result, resp, err := ss.UsersWithContext(ctx, &pageOpt)
+		if err != nil {
+			return nil, nil, err
+		}
+		resp.populateSearchPagingInfo(pageOpt.Page, result.TotalPages)
+		users := make([]User, 0)
+		if result.Results != nil {
+			users = *result.Results
+		}
+		return &users, resp, nil
+	}
+	return p
+}
+
 // Photos queries the search endpoint to search for photos.
+// Use FilterPhotos to narrow the search down by color, orientation etc.
 func (ss *SearchService) Photos(opt *SearchOpt) (*PhotoSearchResult, *Response, error) {
+	return ss.PhotosWithContext(context.Background(), opt)
+}
+
+// PhotosWithContext is like Photos but uses ctx for the request.
+func (ss *SearchService) PhotosWithContext(ctx context.Context, opt *SearchOpt) (*PhotoSearchResult, *Response, error) {
 	if nil == opt {
 		return nil, nil, &IllegalArgumentError{ErrString: "SearchOpt cannot be nil"}
 	}
 	if !opt.Valid() {
 		return nil, nil, &InvalidListOptError{ErrString: "Search query cannot be empty."}
 	}
-	req, err := newRequest(GET, getEndpoint(searchPhotos), opt, nil)
+	return ss.FilterPhotosWithContext(ctx, &PhotoSearchOpt{SearchOpt: *opt})
+}
+
+// PhotosPager returns a PhotoPager over all photos matching the search.
+// opt sets the query, the first page and the page size.
+func (ss *SearchService) PhotosPager(ctx context.Context, opt *SearchOpt, pagerOpt *PagerOpt) *PhotoPager {
+	if nil == opt {
+		return &PhotoPager{pager: pager{err: &IllegalArgumentError{ErrString: "SearchOpt cannot be nil"}}}
+	}
+	return ss.FilterPhotosPager(ctx, &PhotoSearchOpt{SearchOpt: *opt}, pagerOpt)
+}
+
+// FilterPhotos queries the search endpoint to search for photos,
+// applying the filters set in opt.
+func (ss *SearchService) FilterPhotos(opt *PhotoSearchOpt) (*PhotoSearchResult, *Response, error) {
+	return ss.FilterPhotosWithContext(context.Background(), opt)
+}
+
+// FilterPhotosWithContext is like FilterPhotos but uses ctx for the request.
+func (ss *SearchService) FilterPhotosWithContext(ctx context.Context, opt *PhotoSearchOpt) (*PhotoSearchResult, *Response, error) {
+	if nil == opt {
+		return nil, nil, &IllegalArgumentError{ErrString: "PhotoSearchOpt cannot be nil"}
+	}
+	if !opt.SearchOpt.Valid() {
+		return nil, nil, &InvalidListOptError{ErrString: "Search query cannot be empty."}
+	}
+	if !opt.Valid() {
+		return nil, nil, &InvalidListOptError{ErrString: "opt provided is not valid."}
+	}
+	req, err := ss.client.newRequest(ctx, GET, getEndpoint(searchPhotos), opt, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -98,15 +246,45 @@ func (ss *SearchService) Photos(opt *SearchOpt) (*PhotoSearchResult, *Response,
 	return &photos, resp, nil
 }
 
+// FilterPhotosPager returns a PhotoPager over all photos matching the
+// filtered search.
+func (ss *SearchService) FilterPhotosPager(ctx context.Context, opt *PhotoSearchOpt, pagerOpt *PagerOpt) *PhotoPager {
+	if nil == opt {
+		return &PhotoPager{pager: pager{err: &IllegalArgumentError{ErrString: "PhotoSearchOpt cannot be nil"}}}
+	}
+	searchOpt := *opt
+	p := &PhotoPager{pager: newPager(ctx, searchOpt.Page, pagerOpt)}
+	p.fetch = func(ctx context.Context, page int) (*[]Photo, *Response, error) {
+		pageOpt := searchOpt
+		pageOpt.Page = page
+		result, resp, err := ss.FilterPhotosWithContext(ctx, &pageOpt)
+		if err != nil {
+			return nil, nil, err
+		}
+		resp.populateSearchPagingInfo(pageOpt.Page, result.TotalPages)
+		photos := make([]Photo, 0)
+		if result.Results != nil {
+			photos = *result.Results
+		}
+		return &photos, resp, nil
+	}
+	return p
+}
+
 // Collections queries the search endpoint to search for collections.
 func (ss *SearchService) Collections(opt *SearchOpt) (*CollectionSearchResult, *Response, error) {
+	return ss.CollectionsWithContext(context.Background(), opt)
+}
+
+// CollectionsWithContext is like Collections but uses ctx for the request.
+func (ss *SearchService) CollectionsWithContext(ctx context.Context, opt *SearchOpt) (*CollectionSearchResult, *Response, error) {
 	if nil == opt {
 		return nil, nil, &IllegalArgumentError{ErrString: "SearchOpt cannot be nil"}
 	}
 	if !opt.Valid() {
 		return nil, nil, &InvalidListOptError{ErrString: "Search query cannot be empty."}
 	}
-	req, err := newRequest(GET, getEndpoint(searchCollections), opt, nil)
+	req, err := ss.client.newRequest(ctx, GET, getEndpoint(searchCollections), opt, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -121,3 +299,29 @@ func (ss *SearchService) Collections(opt *SearchOpt) (*CollectionSearchResult, *
 	}
 	return &collections, resp, nil
 }
+
+// CollectionsPager returns a CollectionPager over all collections
+// matching the search.
+// opt sets the query, the first page and the page size.
+func (ss *SearchService) CollectionsPager(ctx context.Context, opt *SearchOpt, pagerOpt *PagerOpt) *CollectionPager {
+	if nil == opt {
+		return &CollectionPager{pager: pager{err: &IllegalArgumentError{ErrString: "SearchOpt cannot be nil"}}}
+	}
+	searchOpt := *opt
+	p := &CollectionPager{pager: newPager(ctx, searchOpt.Page, pagerOpt)}
+	p.fetch = func(ctx context.Context, page int) (*[]Collection, *Response, error) {
+		pageOpt := searchOpt
+		pageOpt.Page = page
+		result, resp, err := ss.CollectionsWithContext(ctx, &pageOpt)
+		if err != nil {
+			return nil, nil, err
+		}
+		resp.populateSearchPagingInfo(pageOpt.Page, result.TotalPages)
+		collections := make([]Collection, 0)
+		if result.Results != nil {
+			collections = *result.Results
+		}
+		return &collections, resp, nil
+	}
+	return p
+}
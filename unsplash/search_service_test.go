@@ -24,8 +24,12 @@
 package unsplash
 
 import (
+	"context"
+	"fmt"
 	"io/ioutil"
 	"log"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/jarcoal/httpmock"
@@ -143,3 +147,93 @@ func TestSearchRogueStuff(T *testing.T) {
 	rogueSearchServiceTest(T, httpmock.NewStringResponder(200, `Bad ass Bug flow`))
 	rogueSearchServiceTest(T, nil)
 }
+
+func TestPhotoSearchOpt(T *testing.T) {
+	assert := assert.New(T)
+	var opt PhotoSearchOpt
+	assert.Equal(false, opt.Valid())
+	opt.Query = "gopher"
+	assert.Equal(true, opt.Valid())
+	assert.Equal(1, opt.Page)
+	assert.Equal(10, opt.PerPage)
+
+	for _, invalid := range []PhotoSearchOpt{
+		{OrderBy: Popular},
+		{Orientation: "round"},
+		{Color: "gopher_blue"},
+		{ContentFilter: "medium"},
+		{Lang: "english"},
+		{Lang: "EN"},
+	} {
+		invalid.Query = "gopher"
+		assert.Equal(false, invalid.Valid(), "%+v", invalid)
+	}
+
+	opt = PhotoSearchOpt{
+		SearchOpt:     SearchOpt{Query: "gopher"},
+		OrderBy:       Latest,
+		Orientation:   Portrait,
+		Color:         BlackAndWhite,
+		ContentFilter: ContentFilterHigh,
+		CollectionIDs: []int{206, 42},
+		Lang:          "de",
+	}
+	assert.Equal(true, opt.Valid())
+
+	//filters can be chosen at runtime
+	var color Color = "teal"
+	var filter ContentFilter = "low"
+	opt.Color, opt.ContentFilter = color, filter
+	assert.Equal(true, opt.Valid())
+}
+
+func TestFilterPhotos(T *testing.T) {
+	assert := assert.New(T)
+	log.SetOutput(ioutil.Discard)
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+		fmt.Fprint(w, `{"total": 1, "total_pages": 1, "results": [{"id": "gopherPhoto"}]}`)
+	}))
+	defer server.Close()
+	unsplash := New(nil, WithBaseURL(server.URL))
+
+	opt := &PhotoSearchOpt{
+		SearchOpt:     SearchOpt{Query: "gopher"},
+		OrderBy:       Relevant,
+		Orientation:   Squarish,
+		Color:         Blue,
+		ContentFilter: ContentFilterHigh,
+		CollectionIDs: []int{206, 42},
+		Lang:          "de",
+	}
+	photos, resp, err := unsplash.Search.FilterPhotos(opt)
+	assert.Nil(err)
+	assert.NotNil(resp)
+	assert.Equal("gopherPhoto", *(*photos.Results)[0].ID)
+	assert.Equal("collections=206%2C42&color=blue&content_filter=high&lang=de"+
+		"&order_by=relevant&orientation=squarish&page=1&per_page=10&query=gopher", queries[0])
+
+	//plain SearchOpt sends no filters
+	_, _, err = unsplash.Search.Photos(&SearchOpt{Query: "gopher"})
+	assert.Nil(err)
+	assert.Equal("page=1&per_page=10&query=gopher", queries[1])
+
+	_, _, err = unsplash.Search.FilterPhotos(&PhotoSearchOpt{SearchOpt: SearchOpt{Query: "gopher"}, Color: "plaid"})
+	assert.NotNil(err)
+	_, ok := err.(*InvalidListOptError)
+	assert.Equal(true, ok)
+
+	_, _, err = unsplash.Search.FilterPhotos(nil)
+	assert.NotNil(err)
+	_, ok = err.(*IllegalArgumentError)
+	assert.Equal(true, ok)
+
+	count := 0
+	pager := unsplash.Search.FilterPhotosPager(context.Background(), opt, nil)
+	for pager.Next() {
+		count++
+	}
+	assert.Nil(pager.Err())
+	assert.Equal(1, count)
+}
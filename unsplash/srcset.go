@@ -0,0 +1,219 @@
+// Copyright (c) 2017 Hardik Bagdi <hbagdi1@binghamton.edu>
+//
+// MIT License
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package unsplash
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"strconv"
+	"strings"
+)
+
+// SrcSetOpt configures the responsive image markup generated for a photo.
+// Either Widths or DPRs must be set.
+type SrcSetOpt struct {
+	// Widths lists the image widths offered with w descriptors.
+	Widths []int
+	// DPRs lists the pixel densities offered with x descriptors.
+	// Image.Width or Image.Height must be set to use them.
+	DPRs []float64
+	// Formats lists the formats offered by <source> elements of a
+	// <picture>, in order of preference. The <img> uses Image.Format.
+	Formats []ImageFormat
+	// Sizes is the sizes attribute used with Widths, 100vw if empty.
+	Sizes string
+	// Image holds the imgix parameters shared by every candidate,
+	// like Fit or Quality.
+	Image ImageOpt
+	// Alt overrides the AltDescription of the photo.
+	Alt string
+	// Lazy adds loading="lazy" to the <img>.
+	Lazy bool
+}
+
+func (opt *SrcSetOpt) validate() error {
+	if len(opt.Widths) == 0 && len(opt.DPRs) == 0 {
+		return &IllegalArgumentError{ErrString: "Either Widths or DPRs must be set"}
+	}
+	if len(opt.Widths) != 0 && len(opt.DPRs) != 0 {
+		return &IllegalArgumentError{ErrString: "Widths and DPRs cannot be used at the same time"}
+	}
+	for _, w := range opt.Widths {
+		if w <= 0 {
+			return &IllegalArgumentError{ErrString: "Widths must be positive"}
+		}
+	}
+	for _, dpr := range opt.DPRs {
+		if dpr <= 0 {
+			return &IllegalArgumentError{ErrString: "DPRs must be positive"}
+		}
+	}
+	for _, f := range opt.Formats {
+		if !containsFormat(imageFormats, f) {
+			return &InvalidImageOptError{ErrString: "Unknown format " + string(f)}
+		}
+	}
+	return opt.Image.validate()
+}
+
+// sizes returns the sizes attribute, empty for x descriptors.
+func (opt *SrcSetOpt) sizes() string {
+	if len(opt.Widths) == 0 {
+		return ""
+	}
+	if opt.Sizes == "" {
+		return "100vw"
+	}
+	return opt.Sizes
+}
+
+// SrcSet returns the srcset attribute of the photo in format,
+// or in Image.Format if format is empty.
+func (p *Photo) SrcSet(opt *SrcSetOpt, format ImageFormat) (string, error) {
+	if opt == nil {
+		return "", &IllegalArgumentError{ErrString: "SrcSetOpt cannot be nil"}
+	}
+	if err := opt.validate(); err != nil {
+		return "", err
+	}
+	candidates, err := p.candidates(opt, format)
+	if err != nil {
+		return "", err
+	}
+	return strings.Join(candidates, ", "), nil
+}
+
+// candidates returns the srcset candidates of the photo.
+func (p *Photo) candidates(opt *SrcSetOpt, format ImageFormat) ([]string, error) {
+	var candidates []string
+	image := opt.Image
+	if format != "" {
+		image.Format = format
+	}
+	for _, w := range opt.Widths {
+		candidate := image
+		candidate.Width = w
+		//keep the aspect ratio of a cropped image
+		if image.Width != 0 && image.Height != 0 {
+			candidate.Height = image.Height * w / image.Width
+		}
+		u, err := p.ImageURL(&candidate)
+		if err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, fmt.Sprintf("%v %vw", u, w))
+	}
+	for _, dpr := range opt.DPRs {
+		candidate := image
+		candidate.DPR = dpr
+		u, err := p.ImageURL(&candidate)
+		if err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, fmt.Sprintf("%v %vx", u, strconv.FormatFloat(dpr, 'f', -1, 64)))
+	}
+	return candidates, nil
+}
+
+// ImgHTML returns an <img> element for the photo with srcset and sizes
+// attributes. The first candidate is used as src.
+func (p *Photo) ImgHTML(opt *SrcSetOpt) (string, error) {
+	if opt == nil {
+		return "", &IllegalArgumentError{ErrString: "SrcSetOpt cannot be nil"}
+	}
+	if err := opt.validate(); err != nil {
+		return "", err
+	}
+	candidates, err := p.candidates(opt, "")
+	if err != nil {
+		return "", err
+	}
+	src := candidates[0][:strings.LastIndex(candidates[0], " ")]
+	var buf bytes.Buffer
+	buf.WriteString("<img")
+	writeAttr(&buf, "src", src)
+	writeAttr(&buf, "srcset", strings.Join(candidates, ", "))
+	if sizes := opt.sizes(); sizes != "" {
+		writeAttr(&buf, "sizes", sizes)
+	}
+	alt := opt.Alt
+	if alt == "" && p.AltDescription != nil {
+		alt = *p.AltDescription
+	}
+	writeAttr(&buf, "alt", alt)
+	if p.Width != nil && p.Height != nil {
+		writeAttr(&buf, "width", strconv.Itoa(*p.Width))
+		writeAttr(&buf, "height", strconv.Itoa(*p.Height))
+	}
+	if opt.Lazy {
+		writeAttr(&buf, "loading", "lazy")
+	}
+	buf.WriteString(">")
+	return buf.String(), nil
+}
+
+// PictureHTML returns a <picture> element for the photo with a <source>
+// for each of opt.Formats followed by the <img> of ImgHTML.
+func (p *Photo) PictureHTML(opt *SrcSetOpt) (string, error) {
+	img, err := p.ImgHTML(opt)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	buf.WriteString("<picture>")
+	for _, format := range opt.Formats {
+		srcset, err := p.SrcSet(opt, format)
+		if err != nil {
+			return "", err
+		}
+		buf.WriteString("<source")
+		writeAttr(&buf, "type", format.mimeType())
+		writeAttr(&buf, "srcset", srcset)
+		if sizes := opt.sizes(); sizes != "" {
+			writeAttr(&buf, "sizes", sizes)
+		}
+		buf.WriteString(">")
+	}
+	buf.WriteString(img)
+	buf.WriteString("</picture>")
+	return buf.String(), nil
+}
+
+func (f ImageFormat) mimeType() string {
+	switch f {
+	case FormatJPG, FormatPJPG:
+		return "image/jpeg"
+	default:
+		return "image/" + string(f)
+	}
+}
+
+func writeAttr(buf *bytes.Buffer, name, value string) {
+	buf.WriteString(" ")
+	buf.WriteString(name)
+	buf.WriteString(`="`)
+	buf.WriteString(html.EscapeString(value))
+	buf.WriteString(`"`)
+}
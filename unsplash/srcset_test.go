@@ -0,0 +1,120 @@
+// Copyright (c) 2017 Hardik Bagdi <hbagdi1@binghamton.edu>
+//
+// MIT License
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package unsplash
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func srcSetPhoto() *Photo {
+	var photo Photo
+	data := `{"id": "gopherPhoto", "width": 4000, "height": 3000,
+		"alt_description": "a \"gopher\" & friends",
+		"urls": {"raw": "https://images.unsplash.com/photo-1?ixid=abc&ixlib=rb-1.2.1"}}`
+	if err := json.Unmarshal([]byte(data), &photo); err != nil {
+		panic(err)
+	}
+	return &photo
+}
+
+func TestSrcSet(T *testing.T) {
+	assert := assert.New(T)
+	photo := srcSetPhoto()
+
+	srcset, err := photo.SrcSet(&SrcSetOpt{Widths: []int{400, 800}, Image: ImageOpt{Quality: 80}}, "")
+	assert.Nil(err)
+	assert.Equal("https://images.unsplash.com/photo-1?ixid=abc&ixlib=rb-1.2.1&q=80&w=400 400w, "+
+		"https://images.unsplash.com/photo-1?ixid=abc&ixlib=rb-1.2.1&q=80&w=800 800w", srcset)
+
+	//cropped images keep their aspect ratio
+	opt := &SrcSetOpt{Widths: []int{200}, Image: ImageOpt{Width: 400, Height: 300, Fit: FitCrop}}
+	srcset, err = photo.SrcSet(opt, FormatWebP)
+	assert.Nil(err)
+	assert.Equal("https://images.unsplash.com/photo-1?fit=crop&fm=webp&h=150&ixid=abc&ixlib=rb-1.2.1&w=200 200w", srcset)
+
+	srcset, err = photo.SrcSet(&SrcSetOpt{DPRs: []float64{1, 1.5}, Image: ImageOpt{Width: 300}}, "")
+	assert.Nil(err)
+	assert.Equal("https://images.unsplash.com/photo-1?dpr=1&ixid=abc&ixlib=rb-1.2.1&w=300 1x, "+
+		"https://images.unsplash.com/photo-1?dpr=1.5&ixid=abc&ixlib=rb-1.2.1&w=300 1.5x", srcset)
+
+	for _, invalid := range []*SrcSetOpt{
+		nil,
+		{},
+		{Widths: []int{400}, DPRs: []float64{2}},
+		{Widths: []int{0}},
+		{Widths: []int{400}, Formats: []ImageFormat{"bmp"}},
+		{DPRs: []float64{2}},
+	} {
+		_, err = photo.SrcSet(invalid, "")
+		assert.NotNil(err, "%+v", invalid)
+	}
+	for _, dprs := range [][]float64{{0}, {1, -1}} {
+		_, err = photo.SrcSet(&SrcSetOpt{DPRs: dprs, Image: ImageOpt{Width: 300}}, "")
+		_, ok := err.(*IllegalArgumentError)
+		assert.Equal(true, ok, "%v", dprs)
+	}
+}
+
+func TestPictureHTML(T *testing.T) {
+	assert := assert.New(T)
+	photo := srcSetPhoto()
+
+	opt := &SrcSetOpt{
+		Widths:  []int{400, 800},
+		Formats: []ImageFormat{FormatAVIF, FormatWebP},
+		Sizes:   "(min-width: 800px) 50vw, 100vw",
+		Lazy:    true,
+	}
+	img, err := photo.ImgHTML(opt)
+	assert.Nil(err)
+	assert.Equal(`<img src="https://images.unsplash.com/photo-1?ixid=abc&amp;ixlib=rb-1.2.1&amp;w=400"`+
+		` srcset="https://images.unsplash.com/photo-1?ixid=abc&amp;ixlib=rb-1.2.1&amp;w=400 400w, `+
+		`https://images.unsplash.com/photo-1?ixid=abc&amp;ixlib=rb-1.2.1&amp;w=800 800w"`+
+		` sizes="(min-width: 800px) 50vw, 100vw" alt="a &#34;gopher&#34; &amp; friends"`+
+		` width="4000" height="3000" loading="lazy">`, img)
+
+	picture, err := photo.PictureHTML(opt)
+	assert.Nil(err)
+	assert.Equal(`<picture>`+
+		`<source type="image/avif" srcset="https://images.unsplash.com/photo-1?fm=avif&amp;ixid=abc&amp;ixlib=rb-1.2.1&amp;w=400 400w, `+
+		`https://images.unsplash.com/photo-1?fm=avif&amp;ixid=abc&amp;ixlib=rb-1.2.1&amp;w=800 800w"`+
+		` sizes="(min-width: 800px) 50vw, 100vw">`+
+		`<source type="image/webp" srcset="https://images.unsplash.com/photo-1?fm=webp&amp;ixid=abc&amp;ixlib=rb-1.2.1&amp;w=400 400w, `+
+		`https://images.unsplash.com/photo-1?fm=webp&amp;ixid=abc&amp;ixlib=rb-1.2.1&amp;w=800 800w"`+
+		` sizes="(min-width: 800px) 50vw, 100vw">`+
+		img+`</picture>`, picture)
+
+	//x descriptors come without sizes
+	img, err = photo.ImgHTML(&SrcSetOpt{DPRs: []float64{1, 2}, Image: ImageOpt{Height: 100}, Alt: "gopher"})
+	assert.Nil(err)
+	assert.NotContains(img, "sizes=")
+	assert.Contains(img, `alt="gopher"`)
+	assert.Contains(img, " 2x")
+
+	_, err = photo.PictureHTML(&SrcSetOpt{})
+	assert.NotNil(err)
+}
@@ -0,0 +1,79 @@
+// Copyright (c) 2017 Hardik Bagdi <hbagdi1@binghamton.edu>
+//
+// MIT License
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package unsplash
+
+import (
+	"bytes"
+	"time"
+)
+
+// TopicLinks contains URLs related to a topic
+type TopicLinks struct {
+	Self   *URL `json:"self"`
+	HTML   *URL `json:"html"`
+	Photos *URL `json:"photos"`
+}
+
+// These constants show possible statuses of a Topic
+const (
+	TopicOpen   = "open"
+	TopicClosed = "closed"
+)
+
+// Topic represents a topic on unsplash.com
+type Topic struct {
+	ID                   *string     `json:"id"`
+	Slug                 *string     `json:"slug"`
+	Title                *string     `json:"title"`
+	Description          *string     `json:"description"`
+	PublishedAt          *time.Time  `json:"published_at"`
+	UpdatedAt            *time.Time  `json:"updated_at"`
+	StartsAt             *time.Time  `json:"starts_at"`
+	EndsAt               *time.Time  `json:"ends_at"`
+	OnlySubmissionsAfter *time.Time  `json:"only_submissions_after"`
+	Visibility           *string     `json:"visibility"`
+	Featured             *bool       `json:"featured"`
+	TotalPhotos          *int        `json:"total_photos"`
+	Status               *string     `json:"status"`
+	Links                *TopicLinks `json:"links"`
+	Owners               *[]User     `json:"owners"`
+	TopContributors      *[]User     `json:"top_contributors"`
+	CoverPhoto           *Photo      `json:"cover_photo"`
+	PreviewPhotos        *[]Photo    `json:"preview_photos"`
+}
+
+func (t *Topic) String() string {
+	var buf bytes.Buffer
+	if t.ID == nil {
+		return "Topic is not valid"
+	}
+	buf.WriteString("Topic: ")
+	if t.Title != nil {
+		buf.WriteString(*t.Title)
+	}
+	buf.WriteString("[ID:")
+	buf.WriteString(*t.ID)
+	buf.WriteString("]")
+	return buf.String()
+}
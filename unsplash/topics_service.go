@@ -0,0 +1,221 @@
+// Copyright (c) 2017 Hardik Bagdi <hbagdi1@binghamton.edu>
+//
+// MIT License
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package unsplash
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// TopicsService interacts with /topics endpoint
+type TopicsService service
+
+// These constants should be used for OrderBy in TopicListOpt.
+const (
+	TopicFeatured = "featured"
+	TopicPosition = "position"
+)
+
+var topicOrders = []string{TopicFeatured, Latest, Oldest, TopicPosition}
+
+// TopicListOpt should be used to list topics
+type TopicListOpt struct {
+	Page    int      `url:"page"`
+	PerPage int      `url:"per_page"`
+	OrderBy string   `url:"order_by"`
+	IDs     []string `url:"ids,comma,omitempty"`
+}
+
+var defaultTopicListOpt = &TopicListOpt{
+	Page:    1,
+	PerPage: 10,
+	OrderBy: TopicPosition,
+}
+
+// Valid validates the values in a TopicListOpt
+func (opt *TopicListOpt) Valid() bool {
+	if opt.Page < 0 || opt.PerPage < 0 {
+		return false
+	}
+	if opt.Page == 0 {
+		opt.Page = 1
+	}
+	if opt.PerPage == 0 {
+		opt.PerPage = 10
+	}
+	if opt.OrderBy == "" {
+		opt.OrderBy = TopicPosition
+	}
+	for _, val := range topicOrders {
+		if val == opt.OrderBy {
+			return true
+		}
+	}
+	return false
+}
+
+// TopicPhotosOpt should be used to list photos of a topic
+type TopicPhotosOpt struct {
+	Page        int         `url:"page"`
+	PerPage     int         `url:"per_page"`
+	OrderBy     string      `url:"order_by"`
+	Orientation orientation `url:"orientation,omitempty"`
+}
+
+var defaultTopicPhotosOpt = &TopicPhotosOpt{
+	Page:    1,
+	PerPage: 10,
+	OrderBy: Latest,
+}
+
+// Valid validates the values in a TopicPhotosOpt
+func (opt *TopicPhotosOpt) Valid() bool {
+	if opt.Page < 0 || opt.PerPage < 0 {
+		return false
+	}
+	if opt.Page == 0 {
+		opt.Page = 1
+	}
+	if opt.PerPage == 0 {
+		opt.PerPage = 10
+	}
+	if opt.OrderBy == "" {
+		opt.OrderBy = Latest
+	}
+	if opt.Orientation != "" && !opt.Orientation.valid() {
+		return false
+	}
+	for _, val := range orders {
+		if val == opt.OrderBy {
+			return true
+		}
+	}
+	return false
+}
+
+// All returns a list of topics on unsplash.
+// If opt is nil, topics are ordered by their position.
+func (ts *TopicsService) All(opt *TopicListOpt) (*[]Topic, *Response, error) {
+	return ts.AllWithContext(context.Background(), opt)
+}
+
+// AllWithContext is like All but uses ctx for the request.
+func (ts *TopicsService) AllWithContext(ctx context.Context, opt *TopicListOpt) (*[]Topic, *Response, error) {
+	if nil == opt {
+		opt = defaultTopicListOpt
+	}
+	if !opt.Valid() {
+		return nil, nil, &InvalidListOptError{ErrString: "opt provided is not valid."}
+	}
+	req, err := ts.client.newRequest(ctx, GET, getEndpoint(topics), opt, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	resp, err := ts.client.do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	topics := make([]Topic, 0)
+	err = json.Unmarshal(*resp.body, &topics)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &topics, resp, nil
+}
+
+// Topic returns a topic with id or slug.
+func (ts *TopicsService) Topic(idOrSlug string) (*Topic, *Response, error) {
+	return ts.TopicWithContext(context.Background(), idOrSlug)
+}
+
+// TopicWithContext is like Topic but uses ctx for the request.
+func (ts *TopicsService) TopicWithContext(ctx context.Context, idOrSlug string) (*Topic, *Response, error) {
+	if "" == idOrSlug {
+		return nil, nil, &IllegalArgumentError{ErrString: "Topic ID or slug cannot be null"}
+	}
+	endpoint := fmt.Sprintf("%v/%v", getEndpoint(topics), idOrSlug)
+	req, err := ts.client.newRequest(ctx, GET, endpoint, nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	resp, err := ts.client.do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	var topic Topic
+	err = json.Unmarshal(*resp.body, &topic)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &topic, resp, nil
+}
+
+// Photos returns a list of photos of a topic with id or slug.
+func (ts *TopicsService) Photos(idOrSlug string, opt *TopicPhotosOpt) (*[]Photo, *Response, error) {
+	return ts.PhotosWithContext(context.Background(), idOrSlug, opt)
+}
+
+// PhotosWithContext is like Photos but uses ctx for the request.
+func (ts *TopicsService) PhotosWithContext(ctx context.Context, idOrSlug string, opt *TopicPhotosOpt) (*[]Photo, *Response, error) {
+	if "" == idOrSlug {
+		return nil, nil, &IllegalArgumentError{ErrString: "Topic ID or slug cannot be null"}
+	}
+	if nil == opt {
+		opt = defaultTopicPhotosOpt
+	}
+	if !opt.Valid() {
+		return nil, nil, &InvalidListOptError{ErrString: "opt provided is not valid."}
+	}
+	endpoint := fmt.Sprintf("%v/%v/%v", getEndpoint(topics), idOrSlug, getEndpoint(photos))
+	req, err := ts.client.newRequest(ctx, GET, endpoint, opt, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	resp, err := ts.client.do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	photos := make([]Photo, 0)
+	err = json.Unmarshal(*resp.body, &photos)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &photos, resp, nil
+}
+
+// PhotosPager returns a PhotoPager over photos of a topic with id or slug.
+func (ts *TopicsService) PhotosPager(ctx context.Context, idOrSlug string, opt *TopicPhotosOpt, pagerOpt *PagerOpt) *PhotoPager {
+	if nil == opt {
+		opt = defaultTopicPhotosOpt
+	}
+	photosOpt := *opt
+	p := &PhotoPager{pager: newPager(ctx, photosOpt.Page, pagerOpt)}
+	p.fetch = func(ctx context.Context, page int) (*[]Photo, *Response, error) {
+		pageOpt := photosOpt
+		pageOpt.Page = page
+		return ts.PhotosWithContext(ctx, idOrSlug, &pageOpt)
+	}
+	return p
+}
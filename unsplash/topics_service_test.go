@@ -0,0 +1,123 @@
+// Copyright (c) 2017 Hardik Bagdi <hbagdi1@binghamton.edu>
+//
+// MIT License
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package unsplash
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// topicsServer serves a canned topic list, a topic and its photos,
+// recording the query of every request.
+func topicsServer(queries *[]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*queries = append(*queries, r.URL.RawQuery)
+		switch r.URL.Path {
+		case "/topics":
+			fmt.Fprint(w, `[{"id": "bo8jQKTaE0Y", "slug": "wallpapers", "title": "Wallpapers"},
+				{"id": "CDwuwXJAbEw", "slug": "3d-renders", "title": "3D Renders"}]`)
+		case "/topics/wallpapers":
+			fmt.Fprint(w, `{"id": "bo8jQKTaE0Y", "slug": "wallpapers", "title": "Wallpapers",
+				"status": "open", "total_photos": 2, "featured": true,
+				"owners": [{"id": "QV5S1rtoUJ0", "username": "unsplash"}],
+				"cover_photo": {"id": "lifeO_Gkt-M"},
+				"links": {"photos": "https://api.unsplash.com/topics/wallpapers/photos"}}`)
+		case "/topics/wallpapers/photos":
+			fmt.Fprint(w, `[{"id": "lifeO_Gkt-M"}, {"id": "eOLpJytrbsQ"}]`)
+		default:
+			w.WriteHeader(404)
+			fmt.Fprint(w, `{"errors": ["Couldn't find Topic"]}`)
+		}
+	}))
+}
+
+func TestTopicsService(T *testing.T) {
+	assert := assert.New(T)
+	log.SetOutput(ioutil.Discard)
+	var queries []string
+	server := topicsServer(&queries)
+	defer server.Close()
+	unsplash := New(nil, WithBaseURL(server.URL))
+
+	topics, resp, err := unsplash.Topics.All(nil)
+	assert.Nil(err)
+	assert.NotNil(resp)
+	assert.Equal(2, len(*topics))
+	assert.Equal("wallpapers", *(*topics)[0].Slug)
+	assert.Equal("order_by=position&page=1&per_page=10", queries[0])
+
+	opt := &TopicListOpt{IDs: []string{"wallpapers", "3d-renders"}, OrderBy: Latest}
+	_, _, err = unsplash.Topics.All(opt)
+	assert.Nil(err)
+	assert.Equal("ids=wallpapers%2C3d-renders&order_by=latest&page=1&per_page=10", queries[1])
+
+	_, _, err = unsplash.Topics.All(&TopicListOpt{OrderBy: Popular})
+	assert.NotNil(err)
+	_, ok := err.(*InvalidListOptError)
+	assert.Equal(true, ok)
+
+	topic, _, err := unsplash.Topics.Topic("wallpapers")
+	assert.Nil(err)
+	assert.Equal("bo8jQKTaE0Y", *topic.ID)
+	assert.Equal(TopicOpen, *topic.Status)
+	assert.Equal(true, *topic.Featured)
+	assert.Equal(2, *topic.TotalPhotos)
+	assert.Equal("unsplash", *(*topic.Owners)[0].Username)
+	assert.Equal("lifeO_Gkt-M", *topic.CoverPhoto.ID)
+	assert.Equal("/topics/wallpapers/photos", topic.Links.Photos.Path)
+	assert.NotEmpty(topic.String())
+
+	_, _, err = unsplash.Topics.Topic("")
+	assert.NotNil(err)
+	_, ok = err.(*IllegalArgumentError)
+	assert.Equal(true, ok)
+
+	_, _, err = unsplash.Topics.Topic("nope")
+	assert.NotNil(err)
+	_, ok = err.(*NotFoundError)
+	assert.Equal(true, ok)
+
+	photos, _, err := unsplash.Topics.Photos("wallpapers", &TopicPhotosOpt{Orientation: Portrait, OrderBy: Popular})
+	assert.Nil(err)
+	assert.Equal(2, len(*photos))
+	assert.Equal("order_by=popular&orientation=portrait&page=1&per_page=10", queries[len(queries)-1])
+
+	_, _, err = unsplash.Topics.Photos("wallpapers", &TopicPhotosOpt{Orientation: "round"})
+	assert.NotNil(err)
+	_, ok = err.(*InvalidListOptError)
+	assert.Equal(true, ok)
+
+	pager := unsplash.Topics.PhotosPager(context.Background(), "wallpapers", nil, &PagerOpt{MaxItems: 1})
+	assert.Equal(true, pager.Next())
+	assert.Equal("lifeO_Gkt-M", *pager.Photo().ID)
+	assert.Equal(false, pager.Next())
+	assert.Nil(pager.Err())
+}
@@ -24,11 +24,14 @@
 package unsplash
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
+	"net/url"
+	"strings"
 )
 
 type service struct {
@@ -37,39 +40,70 @@ type service struct {
 
 // Unsplash wraps the entire Unsplash.com API
 type Unsplash struct {
-	client_id   string
-	client      *http.Client
+	client_id     string
+	client        *http.Client
+	baseURL       string
+	userAgent     string
+	acceptVersion string
+	headers       http.Header
+	retryPolicy   *RetryPolicy
+	retryErr      error
+	imageClient   *http.Client
+	rateLimiter   *RateLimiter
+	// trackDownloads makes Photos.Download trigger download_location
+	trackDownloads bool
+	// scopes granted to the token, nil if unknown
+	scopes      Scopes
 	common      service
 	Users       *UsersService
 	Photos      *PhotosService
 	Collections *CollectionsService
 	Search      *SearchService
+	Topics      *TopicsService
 }
 
 //New returns a new Unsplash struct
-func New(client *http.Client) *Unsplash {
+//Options can be supplied to configure the client, see Option.
+func New(client *http.Client, opts ...Option) *Unsplash {
 	if client == nil {
 		client = http.DefaultClient
 	}
 	unsplash := new(Unsplash)
 	unsplash.client = client
+	unsplash.acceptVersion = defaultAcceptVersion
+	for _, opt := range opts {
+		opt(unsplash)
+	}
 	unsplash.common.client = unsplash
 	unsplash.Users = (*UsersService)(&unsplash.common)
 	unsplash.Photos = (*PhotosService)(&unsplash.common)
 	unsplash.Collections = (*CollectionsService)(&unsplash.common)
 	unsplash.Search = (*SearchService)(&unsplash.common)
+	unsplash.Topics = (*TopicsService)(&unsplash.common)
 	return unsplash
 }
 
 //New returns a new Unsplash struct using client_id for Authorization header
 //This will only enable API that do not require user level authorization, but
 //just application level.
-func NewWithClientID(client *http.Client, client_id string) *Unsplash {
-	r := New(client)
+func NewWithClientID(client *http.Client, client_id string, opts ...Option) *Unsplash {
+	r := New(client, opts...)
 	r.client_id = client_id
 	return r
 }
 
+// requireScope returns a MissingScopeError if the client knows
+// that scope was not granted.
+func (s *Unsplash) requireScope(scope Scope) error {
+	if s.scopes == nil || s.scopes.Has(scope) {
+		return nil
+	}
+	return &MissingScopeError{
+		ErrString: fmt.Sprintf("This action requires the %v scope", scope),
+		Scope:     scope,
+	}
+}
+
 func (s *Unsplash) do(req *request) (*Response, error) {
 	var err error
 	//TODO should this be exported?
@@ -77,13 +111,52 @@ func (s *Unsplash) do(req *request) (*Response, error) {
 		return nil,
 			&IllegalArgumentError{ErrString: "Request object cannot be nil"}
 	}
-	req.Request.Header.Set("Accept-Version", "v1")
+	// Don't bother hitting the network if the caller has already given up
+	if err = req.Request.Context().Err(); err != nil {
+		return nil, err
+	}
+	for key, values := range s.headers {
+		for _, value := range values {
+			req.Request.Header.Add(key, value)
+		}
+	}
+	if s.userAgent != "" {
+		req.Request.Header.Set("User-Agent", s.userAgent)
+	}
+	req.Request.Header.Set("Accept-Version", s.acceptVersion)
 	if s.client_id != "" {
 		req.Request.Header.Set("Authorization", fmt.Sprintf("Client-ID %v", s.client_id))
 	}
-	//Make the request
+	if s.retryErr != nil {
+		return nil, s.retryErr
+	}
+	//Make the request, retrying transient failures if configured to
 	client := s.client
-	rawResp, err := client.Do(req.Request)
+	ctx := req.Request.Context()
+	var rawResp *http.Response
+	for attempt := 1; ; attempt++ {
+		if err = s.rateLimiter.wait(ctx); err != nil {
+			return nil, err
+		}
+		rawResp, err = client.Do(req.Request)
+		s.rateLimiter.update(rawResp)
+		// If the context was cancelled or timed out, report that instead of
+		// the transport error so that callers can tell the two apart.
+		if err != nil && ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		if !s.retryPolicy.retry(req.Request, rawResp, err, attempt) {
+			break
+		}
+		wait := s.retryPolicy.backoff(attempt, rawResp)
+		discard(rawResp)
+		if err = sleep(ctx, wait); err != nil {
+			return nil, err
+		}
+		if err = rewind(req.Request); err != nil {
+			return nil, err
+		}
+	}
 	if rawResp != nil {
 		defer rawResp.Body.Close()
 	}
@@ -101,10 +174,66 @@ func (s *Unsplash) do(req *request) (*Response, error) {
 	return resp, nil
 }
 
+// getBaseURL returns the base URL this client resolves endpoints against.
+// Clients without WithBaseURL follow the package default.
+func (s *Unsplash) getBaseURL() string {
+	if s.baseURL != "" {
+		return s.baseURL
+	}
+	return getEndpoint(base)
+}
+
+// getImageClient returns the client image files are downloaded with.
+// Clients without WithImageClient use http.DefaultClient.
+func (s *Unsplash) getImageClient() *http.Client {
+	if s.imageClient != nil {
+		return s.imageClient
+	}
+	return http.DefaultClient
+}
+
+// isAPIURL reports if u has the scheme and host of the base URL, which
+// makes it safe to send the credentials of the client to.
+func (s *Unsplash) isAPIURL(u *url.URL) bool {
+	base, err := url.Parse(s.getBaseURL())
+	if err != nil {
+		return false
+	}
+	return strings.EqualFold(u.Scheme, base.Scheme) && strings.EqualFold(u.Host, base.Host)
+}
+
+// getImage requests the image file at src with the image client, so
+// that no credentials are sent to image hosts.
+// header is added to the request.
+func (s *Unsplash) getImage(ctx context.Context, src *URL, header http.Header) (*http.Response, error) {
+	req, err := http.NewRequest(string(GET), src.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	for key, values := range header {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+	if s.userAgent != "" {
+		req.Header.Set("User-Agent", s.userAgent)
+	}
+	resp, err := s.getImageClient().Do(req.WithContext(ctx))
+	if err != nil && ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	return resp, err
+}
+
 // CurrentUser returns details about the authenticated user
 func (u *Unsplash) CurrentUser() (*User, *Response, error) {
+	return u.CurrentUserWithContext(context.Background())
+}
+
+// CurrentUserWithContext is like CurrentUser but uses ctx for the request.
+func (u *Unsplash) CurrentUserWithContext(ctx context.Context) (*User, *Response, error) {
 	var err error
-	req, err := newRequest(GET, getEndpoint(currentUser), nil, nil)
+	req, err := u.newRequest(ctx, GET, getEndpoint(currentUser), nil, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -123,11 +252,19 @@ func (u *Unsplash) CurrentUser() (*User, *Response, error) {
 
 // UpdateCurrentUser updates the current user's private data and returns an update User struct
 func (u *Unsplash) UpdateCurrentUser(updateInfo *UserUpdateInfo) (*User, *Response, error) {
+	return u.UpdateCurrentUserWithContext(context.Background(), updateInfo)
+}
+
+// UpdateCurrentUserWithContext is like UpdateCurrentUser but uses ctx for the request.
+func (u *Unsplash) UpdateCurrentUserWithContext(ctx context.Context, updateInfo *UserUpdateInfo) (*User, *Response, error) {
+	if err := u.requireScope(WriteUser); err != nil {
+		return nil, nil, err
+	}
 	if updateInfo == nil {
 		return nil, nil, &IllegalArgumentError{ErrString: "updateInfo cannot be null"}
 	}
 	endpoint := "me"
-	req, err := newRequest(PUT, endpoint, updateInfo, nil)
+	req, err := u.newRequest(ctx, PUT, endpoint, updateInfo, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -147,8 +284,14 @@ func (u *Unsplash) UpdateCurrentUser(updateInfo *UserUpdateInfo) (*User, *Respon
 // Stats gives the total photos,download since the inception of unsplash.com
 // This method is DEPRECATED, USE TotalStats()
 func (u *Unsplash) Stats() (*GlobalStats, *Response, error) {
+	return u.StatsWithContext(context.Background())
+}
+
+// StatsWithContext is like Stats but uses ctx for the request.
+// This method is DEPRECATED, USE TotalStatsWithContext()
+func (u *Unsplash) StatsWithContext(ctx context.Context) (*GlobalStats, *Response, error) {
 	var err error
-	req, err := newRequest(GET, getEndpoint(globalStats), nil, nil)
+	req, err := u.newRequest(ctx, GET, getEndpoint(globalStats), nil, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -170,10 +313,20 @@ func (u *Unsplash) TotalStats() (*GlobalStats, *Response, error) {
 	return u.Stats()
 }
 
+// TotalStatsWithContext is like TotalStats but uses ctx for the request.
+func (u *Unsplash) TotalStatsWithContext(ctx context.Context) (*GlobalStats, *Response, error) {
+	return u.StatsWithContext(ctx)
+}
+
 // MonthStats returns various stats related to unsplash.com for last 30 days
 func (u *Unsplash) MonthStats() (*MonthStats, *Response, error) {
+	return u.MonthStatsWithContext(context.Background())
+}
+
+// MonthStatsWithContext is like MonthStats but uses ctx for the request.
+func (u *Unsplash) MonthStatsWithContext(ctx context.Context) (*MonthStats, *Response, error) {
 	var err error
-	req, err := newRequest(GET, getEndpoint(monthStats), nil, nil)
+	req, err := u.newRequest(ctx, GET, getEndpoint(monthStats), nil, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -24,6 +24,7 @@
 package unsplash
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -225,3 +226,37 @@ func TestClientIDPropagation(T *testing.T) {
 	info := httpmock.GetCallCountInfo()
 	assert.Equal(T, 1, info[fmt.Sprintf("GET %v%v", getEndpoint(base), getEndpoint(searchPhotos))])
 }
+
+func TestContextCancellation(T *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	log.SetOutput(ioutil.Discard)
+
+	httpmock.RegisterResponder("GET", getEndpoint(base)+getEndpoint(globalStats),
+		httpmock.NewStringResponder(200, `{"photos": 42}`))
+	assert := assert.New(T)
+	unsplash := New(nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	stats, resp, err := unsplash.TotalStatsWithContext(ctx)
+	assert.Nil(stats)
+	assert.Nil(resp)
+	assert.Equal(context.Canceled, err)
+
+	photos, resp, err := unsplash.Photos.AllWithContext(ctx, nil)
+	assert.Nil(photos)
+	assert.Nil(resp)
+	assert.Equal(context.Canceled, err)
+
+	stats, resp, err = unsplash.TotalStatsWithContext(nil)
+	assert.Nil(stats)
+	assert.Nil(resp)
+	_, ok := err.(*IllegalArgumentError)
+	assert.Equal(true, ok)
+
+	stats, resp, err = unsplash.TotalStatsWithContext(context.Background())
+	assert.Nil(err)
+	assert.NotNil(resp)
+	assert.Equal(uint64(42), stats.Photos)
+}
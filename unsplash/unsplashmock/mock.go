@@ -0,0 +1,126 @@
+// Copyright (c) 2017 Hardik Bagdi <hbagdi1@binghamton.edu>
+//
+// MIT License
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+// Package unsplashmock provides mock implementations of the unsplash
+// service interfaces which record the calls made to them.
+//
+// Each mock has a Func field per method. Both the plain and the
+// WithContext variant of a method call it and are recorded under the
+// plain name.
+//
+//	client := unsplashmock.NewClient()
+//	client.Photos.PhotoFunc = func(ctx context.Context, id string, opt *unsplash.PhotoOpt) (*unsplash.Photo, *unsplash.Response, error) {
+//		return &unsplash.Photo{ID: &id}, &unsplash.Response{}, nil
+//	}
+//	var api unsplash.API = client
+//	photo, _, err := api.PhotosAPI().Photo("photo-id", nil)
+//	calls := client.Photos.CallsTo("Photo")
+//
+// Pager methods without a Func build their pager on top of the
+// WithContext method they page through, so stubbing AllFunc is enough for
+// AllPager.
+package unsplashmock
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/hbagdi/go-unsplash/unsplash"
+)
+
+var (
+	_ unsplash.API            = (*Client)(nil)
+	_ unsplash.PhotosAPI      = (*Photos)(nil)
+	_ unsplash.CollectionsAPI = (*Collections)(nil)
+	_ unsplash.UsersAPI       = (*Users)(nil)
+	_ unsplash.SearchAPI      = (*Search)(nil)
+	_ unsplash.TopicsAPI      = (*Topics)(nil)
+)
+
+// Call is a call made to a mock.
+type Call struct {
+	// Method is the name of the method, without the WithContext suffix.
+	Method string
+	// Args are the arguments of the call, except for the context.
+	Args []interface{}
+}
+
+// Recorder records the calls made to a mock.
+// It is safe for concurrent use.
+type Recorder struct {
+	mu    sync.Mutex
+	calls []Call
+}
+
+func (r *Recorder) record(method string, args ...interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = append(r.calls, Call{Method: method, Args: args})
+}
+
+// Calls returns the calls made so far, in order.
+func (r *Recorder) Calls() []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Call(nil), r.calls...)
+}
+
+// CallsTo returns the calls made so far to method.
+func (r *Recorder) CallsTo(method string) []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var calls []Call
+	for _, call := range r.calls {
+		if call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// Reset forgets the calls made so far.
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = nil
+}
+
+// NotStubbedError is returned by methods of a mock whose Func is nil.
+type NotStubbedError struct {
+	Method string
+}
+
+func (e *NotStubbedError) Error() string {
+	return fmt.Sprintf("unsplashmock: %v is not stubbed", e.Method)
+}
+
+// NewClient returns a Client with a mock for every service.
+func NewClient() *Client {
+	return &Client{
+		Photos:      new(Photos),
+		Collections: new(Collections),
+		Users:       new(Users),
+		Search:      new(Search),
+		Topics:      new(Topics),
+	}
+}
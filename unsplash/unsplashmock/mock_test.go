@@ -0,0 +1,137 @@
+// Copyright (c) 2017 Hardik Bagdi <hbagdi1@binghamton.edu>
+//
+// MIT License
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package unsplashmock_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hbagdi/go-unsplash/unsplash"
+	"github.com/hbagdi/go-unsplash/unsplash/unsplashmock"
+	"github.com/stretchr/testify/assert"
+)
+
+// featuredTitles is the kind of code the mocks are meant for.
+func featuredTitles(api unsplash.API) ([]string, error) {
+	collections, err := api.CollectionsAPI().FeaturedPager(context.Background(), nil, nil).FetchAll(1)
+	if err != nil {
+		return nil, err
+	}
+	var titles []string
+	for _, c := range collections {
+		titles = append(titles, *c.Title)
+	}
+	return titles, nil
+}
+
+func TestMocks(T *testing.T) {
+	assert := assert.New(T)
+	client := unsplashmock.NewClient()
+
+	client.Photos.PhotoFunc = func(ctx context.Context, id string, opt *unsplash.PhotoOpt) (*unsplash.Photo, *unsplash.Response, error) {
+		return &unsplash.Photo{ID: &id}, &unsplash.Response{}, nil
+	}
+	var api unsplash.API = client
+	photo, _, err := api.PhotosAPI().Photo("a", nil)
+	assert.Nil(err)
+	assert.Equal("a", *photo.ID)
+	_, _, err = api.PhotosAPI().PhotoWithContext(context.Background(), "b", &unsplash.PhotoOpt{Width: 10})
+	assert.Nil(err)
+	calls := client.Photos.CallsTo("Photo")
+	assert.Equal(2, len(calls))
+	assert.Equal([]interface{}{"b", &unsplash.PhotoOpt{Width: 10}}, calls[1].Args)
+
+	_, _, err = api.PhotosAPI().Like("a")
+	notStubbed, ok := err.(*unsplashmock.NotStubbedError)
+	assert.Equal(true, ok)
+	assert.Equal("Photos.Like", notStubbed.Method)
+	assert.Equal([]string{"Photo", "Photo", "Like"}, methods(client.Photos.Calls()))
+	client.Photos.Reset()
+	assert.Equal(0, len(client.Photos.Calls()))
+
+	_, err = api.UsersAPI().Follow("gopher")
+	assert.NotNil(err)
+	_, _, err = api.MonthStats()
+	assert.NotNil(err)
+	assert.Equal(1, len(client.CallsTo("MonthStats")))
+}
+
+func TestMockPagers(T *testing.T) {
+	assert := assert.New(T)
+	client := unsplashmock.NewClient()
+	client.Collections.FeaturedFunc = func(ctx context.Context, opt *unsplash.ListOpt) (*[]unsplash.Collection, *unsplash.Response, error) {
+		title := "page " + string(rune('0'+opt.Page))
+		resp := &unsplash.Response{}
+		if opt.Page < 3 {
+			resp.HasNextPage = true
+			resp.NextPage = opt.Page + 1
+		}
+		return &[]unsplash.Collection{{Title: &title}}, resp, nil
+	}
+	titles, err := featuredTitles(client)
+	assert.Nil(err)
+	assert.Equal([]string{"page 1", "page 2", "page 3"}, titles)
+	assert.Equal(1, len(client.Collections.CallsTo("FeaturedPager")))
+	assert.Equal(3, len(client.Collections.CallsTo("Featured")))
+
+	// like the real pagers, they start at the page of the options
+	collections, err := client.Collections.FeaturedPager(context.Background(), &unsplash.ListOpt{Page: 2}, nil).FetchAll(1)
+	assert.Nil(err)
+	assert.Equal(2, len(collections))
+	assert.Equal("page 2", *collections[0].Title)
+
+	// search pagers page through the results
+	client.Search.UsersFunc = func(ctx context.Context, opt *unsplash.SearchOpt) (*unsplash.UserSearchResult, *unsplash.Response, error) {
+		username := opt.Query
+		return &unsplash.UserSearchResult{Results: &[]unsplash.User{{Username: &username}}}, nil, nil
+	}
+	pager := client.Search.UsersPager(context.Background(), &unsplash.SearchOpt{Query: "gopher"}, nil)
+	assert.Equal(true, pager.Next())
+	assert.Equal("gopher", *pager.User().Username)
+	assert.Equal(false, pager.Next())
+	assert.Nil(pager.Err())
+
+	pager = client.Users.FollowersPager(context.Background(), "gopher", nil, nil)
+	assert.Equal(false, pager.Next())
+	_, ok := pager.Err().(*unsplashmock.NotStubbedError)
+	assert.Equal(true, ok)
+
+	failure := errors.New("failure")
+	client.Photos.AllPagerFunc = func(ctx context.Context, opt *unsplash.ListOpt, pagerOpt *unsplash.PagerOpt) *unsplash.PhotoPager {
+		return unsplash.NewPhotoPager(ctx, 0, func(ctx context.Context, page int) (*[]unsplash.Photo, *unsplash.Response, error) {
+			return nil, nil, failure
+		}, pagerOpt)
+	}
+	_, err = client.Photos.AllPager(context.Background(), nil, nil).FetchAll(1)
+	assert.Equal(failure, err)
+}
+
+func methods(calls []unsplashmock.Call) []string {
+	var names []string
+	for _, call := range calls {
+		names = append(names, call.Method)
+	}
+	return names
+}
@@ -0,0 +1,1170 @@
+// Copyright (c) 2017 Hardik Bagdi <hbagdi1@binghamton.edu>
+//
+// MIT License
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package unsplashmock
+
+import (
+	"context"
+	"io"
+
+	"github.com/hbagdi/go-unsplash/unsplash"
+)
+
+// Photos is a mock unsplash.PhotosAPI.
+// Methods without a Func return a *NotStubbedError.
+type Photos struct {
+	Recorder
+
+	PhotoFunc         func(ctx context.Context, id string, photoOpt *unsplash.PhotoOpt) (*unsplash.Photo, *unsplash.Response, error)
+	StatsFunc         func(ctx context.Context, id string) (*unsplash.PhotoStats, *unsplash.Response, error)
+	StatisticsFunc    func(ctx context.Context, id string, opt *unsplash.StatsOpt) (*unsplash.PhotoStatistics, *unsplash.Response, error)
+	DownloadLinkFunc  func(ctx context.Context, id string) (*unsplash.URL, *unsplash.Response, error)
+	UpdateFunc        func(ctx context.Context, id string, opt *unsplash.PhotoUpdateOpt) (*unsplash.Photo, *unsplash.Response, error)
+	TrackDownloadFunc func(ctx context.Context, photo *unsplash.Photo) (*unsplash.URL, *unsplash.Response, error)
+	DownloadFunc      func(ctx context.Context, photo *unsplash.Photo, w io.Writer) (int64, error)
+	AllFunc           func(ctx context.Context, listOpt *unsplash.ListOpt) (*[]unsplash.Photo, *unsplash.Response, error)
+	AllPagerFunc      func(ctx context.Context, listOpt *unsplash.ListOpt, pagerOpt *unsplash.PagerOpt) *unsplash.PhotoPager
+	CuratedFunc       func(ctx context.Context, listOpt *unsplash.ListOpt) (*[]unsplash.Photo, *unsplash.Response, error)
+	CuratedPagerFunc  func(ctx context.Context, listOpt *unsplash.ListOpt, pagerOpt *unsplash.PagerOpt) *unsplash.PhotoPager
+	RandomFunc        func(ctx context.Context, opt *unsplash.RandomPhotoOpt) (*[]unsplash.Photo, *unsplash.Response, error)
+	LikeFunc          func(ctx context.Context, photoID string) (*unsplash.Photo, *unsplash.Response, error)
+	UnlikeFunc        func(ctx context.Context, photoID string) (*unsplash.Photo, *unsplash.Response, error)
+}
+
+// Photo calls PhotoWithContext with context.Background().
+func (p *Photos) Photo(id string, photoOpt *unsplash.PhotoOpt) (*unsplash.Photo, *unsplash.Response, error) {
+	return p.PhotoWithContext(context.Background(), id, photoOpt)
+}
+
+// PhotoWithContext records the call and calls PhotoFunc.
+func (p *Photos) PhotoWithContext(ctx context.Context, id string, photoOpt *unsplash.PhotoOpt) (*unsplash.Photo, *unsplash.Response, error) {
+	p.record("Photo", id, photoOpt)
+	if p.PhotoFunc != nil {
+		return p.PhotoFunc(ctx, id, photoOpt)
+	}
+	return nil, nil, &NotStubbedError{Method: "Photos.Photo"}
+}
+
+// Stats calls StatsWithContext with context.Background().
+func (p *Photos) Stats(id string) (*unsplash.PhotoStats, *unsplash.Response, error) {
+	return p.StatsWithContext(context.Background(), id)
+}
+
+// StatsWithContext records the call and calls StatsFunc.
+func (p *Photos) StatsWithContext(ctx context.Context, id string) (*unsplash.PhotoStats, *unsplash.Response, error) {
+	p.record("Stats", id)
+	if p.StatsFunc != nil {
+		return p.StatsFunc(ctx, id)
+	}
+	return nil, nil, &NotStubbedError{Method: "Photos.Stats"}
+}
+
+// Statistics calls StatisticsWithContext with context.Background().
+func (p *Photos) Statistics(id string, opt *unsplash.StatsOpt) (*unsplash.PhotoStatistics, *unsplash.Response, error) {
+	return p.StatisticsWithContext(context.Background(), id, opt)
+}
+
+// StatisticsWithContext records the call and calls StatisticsFunc.
+func (p *Photos) StatisticsWithContext(ctx context.Context, id string, opt *unsplash.StatsOpt) (*unsplash.PhotoStatistics, *unsplash.Response, error) {
+	p.record("Statistics", id, opt)
+	if p.StatisticsFunc != nil {
+		return p.StatisticsFunc(ctx, id, opt)
+	}
+	return nil, nil, &NotStubbedError{Method: "Photos.Statistics"}
+}
+
+// DownloadLink calls DownloadLinkWithContext with context.Background().
+func (p *Photos) DownloadLink(id string) (*unsplash.URL, *unsplash.Response, error) {
+	return p.DownloadLinkWithContext(context.Background(), id)
+}
+
+// DownloadLinkWithContext records the call and calls DownloadLinkFunc.
+func (p *Photos) DownloadLinkWithContext(ctx context.Context, id string) (*unsplash.URL, *unsplash.Response, error) {
+	p.record("DownloadLink", id)
+	if p.DownloadLinkFunc != nil {
+		return p.DownloadLinkFunc(ctx, id)
+	}
+	return nil, nil, &NotStubbedError{Method: "Photos.DownloadLink"}
+}
+
+// Update calls UpdateWithContext with context.Background().
+func (p *Photos) Update(id string, opt *unsplash.PhotoUpdateOpt) (*unsplash.Photo, *unsplash.Response, error) {
+	return p.UpdateWithContext(context.Background(), id, opt)
+}
+
+// UpdateWithContext records the call and calls UpdateFunc.
+func (p *Photos) UpdateWithContext(ctx context.Context, id string, opt *unsplash.PhotoUpdateOpt) (*unsplash.Photo, *unsplash.Response, error) {
+	p.record("Update", id, opt)
+	if p.UpdateFunc != nil {
+		return p.UpdateFunc(ctx, id, opt)
+	}
+	return nil, nil, &NotStubbedError{Method: "Photos.Update"}
+}
+
+// TrackDownload calls TrackDownloadWithContext with context.Background().
+func (p *Photos) TrackDownload(photo *unsplash.Photo) (*unsplash.URL, *unsplash.Response, error) {
+	return p.TrackDownloadWithContext(context.Background(), photo)
+}
+
+// TrackDownloadWithContext records the call and calls TrackDownloadFunc.
+func (p *Photos) TrackDownloadWithContext(ctx context.Context, photo *unsplash.Photo) (*unsplash.URL, *unsplash.Response, error) {
+	p.record("TrackDownload", photo)
+	if p.TrackDownloadFunc != nil {
+		return p.TrackDownloadFunc(ctx, photo)
+	}
+	return nil, nil, &NotStubbedError{Method: "Photos.TrackDownload"}
+}
+
+// Download calls DownloadWithContext with context.Background().
+func (p *Photos) Download(photo *unsplash.Photo, w io.Writer) (int64, error) {
+	return p.DownloadWithContext(context.Background(), photo, w)
+}
+
+// DownloadWithContext records the call and calls DownloadFunc.
+func (p *Photos) DownloadWithContext(ctx context.Context, photo *unsplash.Photo, w io.Writer) (int64, error) {
+	p.record("Download", photo, w)
+	if p.DownloadFunc != nil {
+		return p.DownloadFunc(ctx, photo, w)
+	}
+	return 0, &NotStubbedError{Method: "Photos.Download"}
+}
+
+// All calls AllWithContext with context.Background().
+func (p *Photos) All(listOpt *unsplash.ListOpt) (*[]unsplash.Photo, *unsplash.Response, error) {
+	return p.AllWithContext(context.Background(), listOpt)
+}
+
+// AllWithContext records the call and calls AllFunc.
+func (p *Photos) AllWithContext(ctx context.Context, listOpt *unsplash.ListOpt) (*[]unsplash.Photo, *unsplash.Response, error) {
+	p.record("All", listOpt)
+	if p.AllFunc != nil {
+		return p.AllFunc(ctx, listOpt)
+	}
+	return nil, nil, &NotStubbedError{Method: "Photos.All"}
+}
+
+// AllPager calls AllPagerFunc. If it is nil, the pager requests its pages
+// from AllWithContext.
+func (p *Photos) AllPager(ctx context.Context, listOpt *unsplash.ListOpt, pagerOpt *unsplash.PagerOpt) *unsplash.PhotoPager {
+	p.record("AllPager", listOpt, pagerOpt)
+	if p.AllPagerFunc != nil {
+		return p.AllPagerFunc(ctx, listOpt, pagerOpt)
+	}
+	start := 0
+	if listOpt != nil {
+		start = listOpt.Page
+	}
+	return unsplash.NewPhotoPager(ctx, start, func(ctx context.Context, page int) (*[]unsplash.Photo, *unsplash.Response, error) {
+		var pageOpt unsplash.ListOpt
+		if listOpt != nil {
+			pageOpt = *listOpt
+		}
+		pageOpt.Page = page
+		return p.AllWithContext(ctx, &pageOpt)
+	}, pagerOpt)
+}
+
+// Curated calls CuratedWithContext with context.Background().
+func (p *Photos) Curated(listOpt *unsplash.ListOpt) (*[]unsplash.Photo, *unsplash.Response, error) {
+	return p.CuratedWithContext(context.Background(), listOpt)
+}
+
+// CuratedWithContext records the call and calls CuratedFunc.
+func (p *Photos) CuratedWithContext(ctx context.Context, listOpt *unsplash.ListOpt) (*[]unsplash.Photo, *unsplash.Response, error) {
+	p.record("Curated", listOpt)
+	if p.CuratedFunc != nil {
+		return p.CuratedFunc(ctx, listOpt)
+	}
+	return nil, nil, &NotStubbedError{Method: "Photos.Curated"}
+}
+
+// CuratedPager calls CuratedPagerFunc. If it is nil, the pager requests its pages
+// from CuratedWithContext.
+func (p *Photos) CuratedPager(ctx context.Context, listOpt *unsplash.ListOpt, pagerOpt *unsplash.PagerOpt) *unsplash.PhotoPager {
+	p.record("CuratedPager", listOpt, pagerOpt)
+	if p.CuratedPagerFunc != nil {
+		return p.CuratedPagerFunc(ctx, listOpt, pagerOpt)
+	}
+	start := 0
+	if listOpt != nil {
+		start = listOpt.Page
+	}
+	return unsplash.NewPhotoPager(ctx, start, func(ctx context.Context, page int) (*[]unsplash.Photo, *unsplash.Response, error) {
+		var pageOpt unsplash.ListOpt
+		if listOpt != nil {
+			pageOpt = *listOpt
+		}
+		pageOpt.Page = page
+		return p.CuratedWithContext(ctx, &pageOpt)
+	}, pagerOpt)
+}
+
+// Random calls RandomWithContext with context.Background().
+func (p *Photos) Random(opt *unsplash.RandomPhotoOpt) (*[]unsplash.Photo, *unsplash.Response, error) {
+	return p.RandomWithContext(context.Background(), opt)
+}
+
+// RandomWithContext records the call and calls RandomFunc.
+func (p *Photos) RandomWithContext(ctx context.Context, opt *unsplash.RandomPhotoOpt) (*[]unsplash.Photo, *unsplash.Response, error) {
+	p.record("Random", opt)
+	if p.RandomFunc != nil {
+		return p.RandomFunc(ctx, opt)
+	}
+	return nil, nil, &NotStubbedError{Method: "Photos.Random"}
+}
+
+// Like calls LikeWithContext with context.Background().
+func (p *Photos) Like(photoID string) (*unsplash.Photo, *unsplash.Response, error) {
+	return p.LikeWithContext(context.Background(), photoID)
+}
+
+// LikeWithContext records the call and calls LikeFunc.
+func (p *Photos) LikeWithContext(ctx context.Context, photoID string) (*unsplash.Photo, *unsplash.Response, error) {
+	p.record("Like", photoID)
+	if p.LikeFunc != nil {
+		return p.LikeFunc(ctx, photoID)
+	}
+	return nil, nil, &NotStubbedError{Method: "Photos.Like"}
+}
+
+// Unlike calls UnlikeWithContext with context.Background().
+func (p *Photos) Unlike(photoID string) (*unsplash.Photo, *unsplash.Response, error) {
+	return p.UnlikeWithContext(context.Background(), photoID)
+}
+
+// UnlikeWithContext records the call and calls UnlikeFunc.
+func (p *Photos) UnlikeWithContext(ctx context.Context, photoID string) (*unsplash.Photo, *unsplash.Response, error) {
+	p.record("Unlike", photoID)
+	if p.UnlikeFunc != nil {
+		return p.UnlikeFunc(ctx, photoID)
+	}
+	return nil, nil, &NotStubbedError{Method: "Photos.Unlike"}
+}
+
+// Collections is a mock unsplash.CollectionsAPI.
+// Methods without a Func return a *NotStubbedError.
+type Collections struct {
+	Recorder
+
+	AllFunc           func(ctx context.Context, opt *unsplash.ListOpt) (*[]unsplash.Collection, *unsplash.Response, error)
+	AllPagerFunc      func(ctx context.Context, opt *unsplash.ListOpt, pagerOpt *unsplash.PagerOpt) *unsplash.CollectionPager
+	FeaturedFunc      func(ctx context.Context, opt *unsplash.ListOpt) (*[]unsplash.Collection, *unsplash.Response, error)
+	FeaturedPagerFunc func(ctx context.Context, opt *unsplash.ListOpt, pagerOpt *unsplash.PagerOpt) *unsplash.CollectionPager
+	CuratedFunc       func(ctx context.Context, opt *unsplash.ListOpt) (*[]unsplash.Collection, *unsplash.Response, error)
+	CuratedPagerFunc  func(ctx context.Context, opt *unsplash.ListOpt, pagerOpt *unsplash.PagerOpt) *unsplash.CollectionPager
+	RelatedFunc       func(ctx context.Context, id string, opt *unsplash.ListOpt) (*[]unsplash.Collection, *unsplash.Response, error)
+	RelatedPagerFunc  func(ctx context.Context, id string, opt *unsplash.ListOpt, pagerOpt *unsplash.PagerOpt) *unsplash.CollectionPager
+	PhotosFunc        func(ctx context.Context, id string, opt *unsplash.CollectionPhotosOpt) (*[]unsplash.Photo, *unsplash.Response, error)
+	PhotosPagerFunc   func(ctx context.Context, id string, opt *unsplash.CollectionPhotosOpt, pagerOpt *unsplash.PagerOpt) *unsplash.PhotoPager
+	CollectionFunc    func(ctx context.Context, id string) (*unsplash.Collection, *unsplash.Response, error)
+	CreateFunc        func(ctx context.Context, opt *unsplash.CollectionOpt) (*unsplash.Collection, *unsplash.Response, error)
+	UpdateFunc        func(ctx context.Context, collectionID int, opt *unsplash.CollectionOpt) (*unsplash.Collection, *unsplash.Response, error)
+	DeleteFunc        func(ctx context.Context, collectionID int) (*unsplash.Response, error)
+	AddPhotoFunc      func(ctx context.Context, collectionID int, photoID string) (*unsplash.Response, error)
+	RemovePhotoFunc   func(ctx context.Context, collectionID int, photoID string) (*unsplash.Response, error)
+}
+
+// All calls AllWithContext with context.Background().
+func (c *Collections) All(opt *unsplash.ListOpt) (*[]unsplash.Collection, *unsplash.Response, error) {
+	return c.AllWithContext(context.Background(), opt)
+}
+
+// AllWithContext records the call and calls AllFunc.
+func (c *Collections) AllWithContext(ctx context.Context, opt *unsplash.ListOpt) (*[]unsplash.Collection, *unsplash.Response, error) {
+	c.record("All", opt)
+	if c.AllFunc != nil {
+		return c.AllFunc(ctx, opt)
+	}
+	return nil, nil, &NotStubbedError{Method: "Collections.All"}
+}
+
+// AllPager calls AllPagerFunc. If it is nil, the pager requests its pages
+// from AllWithContext.
+func (c *Collections) AllPager(ctx context.Context, opt *unsplash.ListOpt, pagerOpt *unsplash.PagerOpt) *unsplash.CollectionPager {
+	c.record("AllPager", opt, pagerOpt)
+	if c.AllPagerFunc != nil {
+		return c.AllPagerFunc(ctx, opt, pagerOpt)
+	}
+	start := 0
+	if opt != nil {
+		start = opt.Page
+	}
+	return unsplash.NewCollectionPager(ctx, start, func(ctx context.Context, page int) (*[]unsplash.Collection, *unsplash.Response, error) {
+		var pageOpt unsplash.ListOpt
+		if opt != nil {
+			pageOpt = *opt
+		}
+		pageOpt.Page = page
+		return c.AllWithContext(ctx, &pageOpt)
+	}, pagerOpt)
+}
+
+// Featured calls FeaturedWithContext with context.Background().
+func (c *Collections) Featured(opt *unsplash.ListOpt) (*[]unsplash.Collection, *unsplash.Response, error) {
+	return c.FeaturedWithContext(context.Background(), opt)
+}
+
+// FeaturedWithContext records the call and calls FeaturedFunc.
+func (c *Collections) FeaturedWithContext(ctx context.Context, opt *unsplash.ListOpt) (*[]unsplash.Collection, *unsplash.Response, error) {
+	c.record("Featured", opt)
+	if c.FeaturedFunc != nil {
+		return c.FeaturedFunc(ctx, opt)
+	}
+	return nil, nil, &NotStubbedError{Method: "Collections.Featured"}
+}
+
+// FeaturedPager calls FeaturedPagerFunc. If it is nil, the pager requests its pages
+// from FeaturedWithContext.
+func (c *Collections) FeaturedPager(ctx context.Context, opt *unsplash.ListOpt, pagerOpt *unsplash.PagerOpt) *unsplash.CollectionPager {
+	c.record("FeaturedPager", opt, pagerOpt)
+	if c.FeaturedPagerFunc != nil {
+		return c.FeaturedPagerFunc(ctx, opt, pagerOpt)
+	}
+	start := 0
+	if opt != nil {
+		start = opt.Page
+	}
+	return unsplash.NewCollectionPager(ctx, start, func(ctx context.Context, page int) (*[]unsplash.Collection, *unsplash.Response, error) {
+		var pageOpt unsplash.ListOpt
+		if opt != nil {
+			pageOpt = *opt
+		}
+		pageOpt.Page = page
+		return c.FeaturedWithContext(ctx, &pageOpt)
+	}, pagerOpt)
+}
+
+// Curated calls CuratedWithContext with context.Background().
+func (c *Collections) Curated(opt *unsplash.ListOpt) (*[]unsplash.Collection, *unsplash.Response, error) {
+	return c.CuratedWithContext(context.Background(), opt)
+}
+
+// CuratedWithContext records the call and calls CuratedFunc.
+func (c *Collections) CuratedWithContext(ctx context.Context, opt *unsplash.ListOpt) (*[]unsplash.Collection, *unsplash.Response, error) {
+	c.record("Curated", opt)
+	if c.CuratedFunc != nil {
+		return c.CuratedFunc(ctx, opt)
+	}
+	return nil, nil, &NotStubbedError{Method: "Collections.Curated"}
+}
+
+// CuratedPager calls CuratedPagerFunc. If it is nil, the pager requests its pages
+// from CuratedWithContext.
+func (c *Collections) CuratedPager(ctx context.Context, opt *unsplash.ListOpt, pagerOpt *unsplash.PagerOpt) *unsplash.CollectionPager {
+	c.record("CuratedPager", opt, pagerOpt)
+	if c.CuratedPagerFunc != nil {
+		return c.CuratedPagerFunc(ctx, opt, pagerOpt)
+	}
+	start := 0
+	if opt != nil {
+		start = opt.Page
+	}
+	return unsplash.NewCollectionPager(ctx, start, func(ctx context.Context, page int) (*[]unsplash.Collection, *unsplash.Response, error) {
+		var pageOpt unsplash.ListOpt
+		if opt != nil {
+			pageOpt = *opt
+		}
+		pageOpt.Page = page
+		return c.CuratedWithContext(ctx, &pageOpt)
+	}, pagerOpt)
+}
+
+// Related calls RelatedWithContext with context.Background().
+func (c *Collections) Related(id string, opt *unsplash.ListOpt) (*[]unsplash.Collection, *unsplash.Response, error) {
+	return c.RelatedWithContext(context.Background(), id, opt)
+}
+
+// RelatedWithContext records the call and calls RelatedFunc.
+func (c *Collections) RelatedWithContext(ctx context.Context, id string, opt *unsplash.ListOpt) (*[]unsplash.Collection, *unsplash.Response, error) {
+	c.record("Related", id, opt)
+	if c.RelatedFunc != nil {
+		return c.RelatedFunc(ctx, id, opt)
+	}
+	return nil, nil, &NotStubbedError{Method: "Collections.Related"}
+}
+
+// RelatedPager calls RelatedPagerFunc. If it is nil, the pager requests its pages
+// from RelatedWithContext.
+func (c *Collections) RelatedPager(ctx context.Context, id string, opt *unsplash.ListOpt, pagerOpt *unsplash.PagerOpt) *unsplash.CollectionPager {
+	c.record("RelatedPager", id, opt, pagerOpt)
+	if c.RelatedPagerFunc != nil {
+		return c.RelatedPagerFunc(ctx, id, opt, pagerOpt)
+	}
+	start := 0
+	if opt != nil {
+		start = opt.Page
+	}
+	return unsplash.NewCollectionPager(ctx, start, func(ctx context.Context, page int) (*[]unsplash.Collection, *unsplash.Response, error) {
+		var pageOpt unsplash.ListOpt
+		if opt != nil {
+			pageOpt = *opt
+		}
+		pageOpt.Page = page
+		return c.RelatedWithContext(ctx, id, &pageOpt)
+	}, pagerOpt)
+}
+
+// Photos calls PhotosWithContext with context.Background().
+func (c *Collections) Photos(id string, opt *unsplash.CollectionPhotosOpt) (*[]unsplash.Photo, *unsplash.Response, error) {
+	return c.PhotosWithContext(context.Background(), id, opt)
+}
+
+// PhotosWithContext records the call and calls PhotosFunc.
+func (c *Collections) PhotosWithContext(ctx context.Context, id string, opt *unsplash.CollectionPhotosOpt) (*[]unsplash.Photo, *unsplash.Response, error) {
+	c.record("Photos", id, opt)
+	if c.PhotosFunc != nil {
+		return c.PhotosFunc(ctx, id, opt)
+	}
+	return nil, nil, &NotStubbedError{Method: "Collections.Photos"}
+}
+
+// PhotosPager calls PhotosPagerFunc. If it is nil, the pager requests its pages
+// from PhotosWithContext.
+func (c *Collections) PhotosPager(ctx context.Context, id string, opt *unsplash.CollectionPhotosOpt, pagerOpt *unsplash.PagerOpt) *unsplash.PhotoPager {
+	c.record("PhotosPager", id, opt, pagerOpt)
+	if c.PhotosPagerFunc != nil {
+		return c.PhotosPagerFunc(ctx, id, opt, pagerOpt)
+	}
+	start := 0
+	if opt != nil {
+		start = opt.Page
+	}
+	return unsplash.NewPhotoPager(ctx, start, func(ctx context.Context, page int) (*[]unsplash.Photo, *unsplash.Response, error) {
+		var pageOpt unsplash.CollectionPhotosOpt
+		if opt != nil {
+			pageOpt = *opt
+		}
+		pageOpt.Page = page
+		return c.PhotosWithContext(ctx, id, &pageOpt)
+	}, pagerOpt)
+}
+
+// Collection calls CollectionWithContext with context.Background().
+func (c *Collections) Collection(id string) (*unsplash.Collection, *unsplash.Response, error) {
+	return c.CollectionWithContext(context.Background(), id)
+}
+
+// CollectionWithContext records the call and calls CollectionFunc.
+func (c *Collections) CollectionWithContext(ctx context.Context, id string) (*unsplash.Collection, *unsplash.Response, error) {
+	c.record("Collection", id)
+	if c.CollectionFunc != nil {
+		return c.CollectionFunc(ctx, id)
+	}
+	return nil, nil, &NotStubbedError{Method: "Collections.Collection"}
+}
+
+// Create calls CreateWithContext with context.Background().
+func (c *Collections) Create(opt *unsplash.CollectionOpt) (*unsplash.Collection, *unsplash.Response, error) {
+	return c.CreateWithContext(context.Background(), opt)
+}
+
+// CreateWithContext records the call and calls CreateFunc.
+func (c *Collections) CreateWithContext(ctx context.Context, opt *unsplash.CollectionOpt) (*unsplash.Collection, *unsplash.Response, error) {
+	c.record("Create", opt)
+	if c.CreateFunc != nil {
+		return c.CreateFunc(ctx, opt)
+	}
+	return nil, nil, &NotStubbedError{Method: "Collections.Create"}
+}
+
+// Update calls UpdateWithContext with context.Background().
+func (c *Collections) Update(collectionID int, opt *unsplash.CollectionOpt) (*unsplash.Collection, *unsplash.Response, error) {
+	return c.UpdateWithContext(context.Background(), collectionID, opt)
+}
+
+// UpdateWithContext records the call and calls UpdateFunc.
+func (c *Collections) UpdateWithContext(ctx context.Context, collectionID int, opt *unsplash.CollectionOpt) (*unsplash.Collection, *unsplash.Response, error) {
+	c.record("Update", collectionID, opt)
+	if c.UpdateFunc != nil {
+		return c.UpdateFunc(ctx, collectionID, opt)
+	}
+	return nil, nil, &NotStubbedError{Method: "Collections.Update"}
+}
+
+// Delete calls DeleteWithContext with context.Background().
+func (c *Collections) Delete(collectionID int) (*unsplash.Response, error) {
+	return c.DeleteWithContext(context.Background(), collectionID)
+}
+
+// DeleteWithContext records the call and calls DeleteFunc.
+func (c *Collections) DeleteWithContext(ctx context.Context, collectionID int) (*unsplash.Response, error) {
+	c.record("Delete", collectionID)
+	if c.DeleteFunc != nil {
+		return c.DeleteFunc(ctx, collectionID)
+	}
+	return nil, &NotStubbedError{Method: "Collections.Delete"}
+}
+
+// AddPhoto calls AddPhotoWithContext with context.Background().
+func (c *Collections) AddPhoto(collectionID int, photoID string) (*unsplash.Response, error) {
+	return c.AddPhotoWithContext(context.Background(), collectionID, photoID)
+}
+
+// AddPhotoWithContext records the call and calls AddPhotoFunc.
+func (c *Collections) AddPhotoWithContext(ctx context.Context, collectionID int, photoID string) (*unsplash.Response, error) {
+	c.record("AddPhoto", collectionID, photoID)
+	if c.AddPhotoFunc != nil {
+		return c.AddPhotoFunc(ctx, collectionID, photoID)
+	}
+	return nil, &NotStubbedError{Method: "Collections.AddPhoto"}
+}
+
+// RemovePhoto calls RemovePhotoWithContext with context.Background().
+func (c *Collections) RemovePhoto(collectionID int, photoID string) (*unsplash.Response, error) {
+	return c.RemovePhotoWithContext(context.Background(), collectionID, photoID)
+}
+
+// RemovePhotoWithContext records the call and calls RemovePhotoFunc.
+func (c *Collections) RemovePhotoWithContext(ctx context.Context, collectionID int, photoID string) (*unsplash.Response, error) {
+	c.record("RemovePhoto", collectionID, photoID)
+	if c.RemovePhotoFunc != nil {
+		return c.RemovePhotoFunc(ctx, collectionID, photoID)
+	}
+	return nil, &NotStubbedError{Method: "Collections.RemovePhoto"}
+}
+
+// Users is a mock unsplash.UsersAPI.
+// Methods without a Func return a *NotStubbedError.
+type Users struct {
+	Recorder
+
+	UserFunc             func(ctx context.Context, username string, imageOpt *unsplash.ProfileImageOpt) (*unsplash.User, error)
+	PortfolioFunc        func(ctx context.Context, username string) (*unsplash.URL, error)
+	PhotosFunc           func(ctx context.Context, username string, opt *unsplash.ListOpt) (*[]unsplash.Photo, *unsplash.Response, error)
+	PhotosPagerFunc      func(ctx context.Context, username string, opt *unsplash.ListOpt, pagerOpt *unsplash.PagerOpt) *unsplash.PhotoPager
+	LikedPhotosFunc      func(ctx context.Context, username string, opt *unsplash.ListOpt) (*[]unsplash.Photo, *unsplash.Response, error)
+	LikedPhotosPagerFunc func(ctx context.Context, username string, opt *unsplash.ListOpt, pagerOpt *unsplash.PagerOpt) *unsplash.PhotoPager
+	CollectionsFunc      func(ctx context.Context, username string, opt *unsplash.ListOpt) (*[]unsplash.Collection, *unsplash.Response, error)
+	CollectionsPagerFunc func(ctx context.Context, username string, opt *unsplash.ListOpt, pagerOpt *unsplash.PagerOpt) *unsplash.CollectionPager
+	FollowersFunc        func(ctx context.Context, username string, opt *unsplash.ListOpt) (*[]unsplash.User, *unsplash.Response, error)
+	FollowersPagerFunc   func(ctx context.Context, username string, opt *unsplash.ListOpt, pagerOpt *unsplash.PagerOpt) *unsplash.UserPager
+	FollowingFunc        func(ctx context.Context, username string, opt *unsplash.ListOpt) (*[]unsplash.User, *unsplash.Response, error)
+	FollowingPagerFunc   func(ctx context.Context, username string, opt *unsplash.ListOpt, pagerOpt *unsplash.PagerOpt) *unsplash.UserPager
+	FollowFunc           func(ctx context.Context, username string) (*unsplash.Response, error)
+	UnfollowFunc         func(ctx context.Context, username string) (*unsplash.Response, error)
+	StatisticsFunc       func(ctx context.Context, username string, opt *unsplash.StatsOpt) (*unsplash.UserStatistics, *unsplash.Response, error)
+}
+
+// User calls UserWithContext with context.Background().
+func (u *Users) User(username string, imageOpt *unsplash.ProfileImageOpt) (*unsplash.User, error) {
+	return u.UserWithContext(context.Background(), username, imageOpt)
+}
+
+// UserWithContext records the call and calls UserFunc.
+func (u *Users) UserWithContext(ctx context.Context, username string, imageOpt *unsplash.ProfileImageOpt) (*unsplash.User, error) {
+	u.record("User", username, imageOpt)
+	if u.UserFunc != nil {
+		return u.UserFunc(ctx, username, imageOpt)
+	}
+	return nil, &NotStubbedError{Method: "Users.User"}
+}
+
+// Portfolio calls PortfolioWithContext with context.Background().
+func (u *Users) Portfolio(username string) (*unsplash.URL, error) {
+	return u.PortfolioWithContext(context.Background(), username)
+}
+
+// PortfolioWithContext records the call and calls PortfolioFunc.
+func (u *Users) PortfolioWithContext(ctx context.Context, username string) (*unsplash.URL, error) {
+	u.record("Portfolio", username)
+	if u.PortfolioFunc != nil {
+		return u.PortfolioFunc(ctx, username)
+	}
+	return nil, &NotStubbedError{Method: "Users.Portfolio"}
+}
+
+// Photos calls PhotosWithContext with context.Background().
+func (u *Users) Photos(username string, opt *unsplash.ListOpt) (*[]unsplash.Photo, *unsplash.Response, error) {
+	return u.PhotosWithContext(context.Background(), username, opt)
+}
+
+// PhotosWithContext records the call and calls PhotosFunc.
+func (u *Users) PhotosWithContext(ctx context.Context, username string, opt *unsplash.ListOpt) (*[]unsplash.Photo, *unsplash.Response, error) {
+	u.record("Photos", username, opt)
+	if u.PhotosFunc != nil {
+		return u.PhotosFunc(ctx, username, opt)
+	}
+	return nil, nil, &NotStubbedError{Method: "Users.Photos"}
+}
+
+// PhotosPager calls PhotosPagerFunc. If it is nil, the pager requests its pages
+// from PhotosWithContext.
+func (u *Users) PhotosPager(ctx context.Context, username string, opt *unsplash.ListOpt, pagerOpt *unsplash.PagerOpt) *unsplash.PhotoPager {
+	u.record("PhotosPager", username, opt, pagerOpt)
+	if u.PhotosPagerFunc != nil {
+		return u.PhotosPagerFunc(ctx, username, opt, pagerOpt)
+	}
+	start := 0
+	if opt != nil {
+		start = opt.Page
+	}
+	return unsplash.NewPhotoPager(ctx, start, func(ctx context.Context, page int) (*[]unsplash.Photo, *unsplash.Response, error) {
+		var pageOpt unsplash.ListOpt
+		if opt != nil {
+			pageOpt = *opt
+		}
+		pageOpt.Page = page
+		return u.PhotosWithContext(ctx, username, &pageOpt)
+	}, pagerOpt)
+}
+
+// LikedPhotos calls LikedPhotosWithContext with context.Background().
+func (u *Users) LikedPhotos(username string, opt *unsplash.ListOpt) (*[]unsplash.Photo, *unsplash.Response, error) {
+	return u.LikedPhotosWithContext(context.Background(), username, opt)
+}
+
+// LikedPhotosWithContext records the call and calls LikedPhotosFunc.
+func (u *Users) LikedPhotosWithContext(ctx context.Context, username string, opt *unsplash.ListOpt) (*[]unsplash.Photo, *unsplash.Response, error) {
+	u.record("LikedPhotos", username, opt)
+	if u.LikedPhotosFunc != nil {
+		return u.LikedPhotosFunc(ctx, username, opt)
+	}
+	return nil, nil, &NotStubbedError{Method: "Users.LikedPhotos"}
+}
+
+// LikedPhotosPager calls LikedPhotosPagerFunc. If it is nil, the pager requests its pages
+// from LikedPhotosWithContext.
+func (u *Users) LikedPhotosPager(ctx context.Context, username string, opt *unsplash.ListOpt, pagerOpt *unsplash.PagerOpt) *unsplash.PhotoPager {
+	u.record("LikedPhotosPager", username, opt, pagerOpt)
+	if u.LikedPhotosPagerFunc != nil {
+		return u.LikedPhotosPagerFunc(ctx, username, opt, pagerOpt)
+	}
+	start := 0
+	if opt != nil {
+		start = opt.Page
+	}
+	return unsplash.NewPhotoPager(ctx, start, func(ctx context.Context, page int) (*[]unsplash.Photo, *unsplash.Response, error) {
+		var pageOpt unsplash.ListOpt
+		if opt != nil {
+			pageOpt = *opt
+		}
+		pageOpt.Page = page
+		return u.LikedPhotosWithContext(ctx, username, &pageOpt)
+	}, pagerOpt)
+}
+
+// Collections calls CollectionsWithContext with context.Background().
+func (u *Users) Collections(username string, opt *unsplash.ListOpt) (*[]unsplash.Collection, *unsplash.Response, error) {
+	return u.CollectionsWithContext(context.Background(), username, opt)
+}
+
+// CollectionsWithContext records the call and calls CollectionsFunc.
+func (u *Users) CollectionsWithContext(ctx context.Context, username string, opt *unsplash.ListOpt) (*[]unsplash.Collection, *unsplash.Response, error) {
+	u.record("Collections", username, opt)
+	if u.CollectionsFunc != nil {
+		return u.CollectionsFunc(ctx, username, opt)
+	}
+	return nil, nil, &NotStubbedError{Method: "Users.Collections"}
+}
+
+// CollectionsPager calls CollectionsPagerFunc. If it is nil, the pager requests its pages
+// from CollectionsWithContext.
+func (u *Users) CollectionsPager(ctx context.Context, username string, opt *unsplash.ListOpt, pagerOpt *unsplash.PagerOpt) *unsplash.CollectionPager {
+	u.record("CollectionsPager", username, opt, pagerOpt)
+	if u.CollectionsPagerFunc != nil {
+		return u.CollectionsPagerFunc(ctx, username, opt, pagerOpt)
+	}
+	start := 0
+	if opt != nil {
+		start = opt.Page
+	}
+	return unsplash.NewCollectionPager(ctx, start, func(ctx context.Context, page int) (*[]unsplash.Collection, *unsplash.Response, error) {
+		var pageOpt unsplash.ListOpt
+		if opt != nil {
+			pageOpt = *opt
+		}
+		pageOpt.Page = page
+		return u.CollectionsWithContext(ctx, username, &pageOpt)
+	}, pagerOpt)
+}
+
+// Followers calls FollowersWithContext with context.Background().
+func (u *Users) Followers(username string, opt *unsplash.ListOpt) (*[]unsplash.User, *unsplash.Response, error) {
+	return u.FollowersWithContext(context.Background(), username, opt)
+}
+
+// FollowersWithContext records the call and calls FollowersFunc.
+func (u *Users) FollowersWithContext(ctx context.Context, username string, opt *unsplash.ListOpt) (*[]unsplash.User, *unsplash.Response, error) {
+	u.record("Followers", username, opt)
+	if u.FollowersFunc != nil {
+		return u.FollowersFunc(ctx, username, opt)
+	}
+	return nil, nil, &NotStubbedError{Method: "Users.Followers"}
+}
+
+// FollowersPager calls FollowersPagerFunc. If it is nil, the pager requests its pages
+// from FollowersWithContext.
+func (u *Users) FollowersPager(ctx context.Context, username string, opt *unsplash.ListOpt, pagerOpt *unsplash.PagerOpt) *unsplash.UserPager {
+	u.record("FollowersPager", username, opt, pagerOpt)
+	if u.FollowersPagerFunc != nil {
+		return u.FollowersPagerFunc(ctx, username, opt, pagerOpt)
+	}
+	start := 0
+	if opt != nil {
+		start = opt.Page
+	}
+	return unsplash.NewUserPager(ctx, start, func(ctx context.Context, page int) (*[]unsplash.User, *unsplash.Response, error) {
+		var pageOpt unsplash.ListOpt
+		if opt != nil {
+			pageOpt = *opt
+		}
+		pageOpt.Page = page
+		return u.FollowersWithContext(ctx, username, &pageOpt)
+	}, pagerOpt)
+}
+
+// Following calls FollowingWithContext with context.Background().
+func (u *Users) Following(username string, opt *unsplash.ListOpt) (*[]unsplash.User, *unsplash.Response, error) {
+	return u.FollowingWithContext(context.Background(), username, opt)
+}
+
+// FollowingWithContext records the call and calls FollowingFunc.
+func (u *Users) FollowingWithContext(ctx context.Context, username string, opt *unsplash.ListOpt) (*[]unsplash.User, *unsplash.Response, error) {
+	u.record("Following", username, opt)
+	if u.FollowingFunc != nil {
+		return u.FollowingFunc(ctx, username, opt)
+	}
+	return nil, nil, &NotStubbedError{Method: "Users.Following"}
+}
+
+// FollowingPager calls FollowingPagerFunc. If it is nil, the pager requests its pages
+// from FollowingWithContext.
+func (u *Users) FollowingPager(ctx context.Context, username string, opt *unsplash.ListOpt, pagerOpt *unsplash.PagerOpt) *unsplash.UserPager {
+	u.record("FollowingPager", username, opt, pagerOpt)
+	if u.FollowingPagerFunc != nil {
+		return u.FollowingPagerFunc(ctx, username, opt, pagerOpt)
+	}
+	start := 0
+	if opt != nil {
+		start = opt.Page
+	}
+	return unsplash.NewUserPager(ctx, start, func(ctx context.Context, page int) (*[]unsplash.User, *unsplash.Response, error) {
+		var pageOpt unsplash.ListOpt
+		if opt != nil {
+			pageOpt = *opt
+		}
+		pageOpt.Page = page
+		return u.FollowingWithContext(ctx, username, &pageOpt)
+	}, pagerOpt)
+}
+
+// Follow calls FollowWithContext with context.Background().
+func (u *Users) Follow(username string) (*unsplash.Response, error) {
+	return u.FollowWithContext(context.Background(), username)
+}
+
+// FollowWithContext records the call and calls FollowFunc.
+func (u *Users) FollowWithContext(ctx context.Context, username string) (*unsplash.Response, error) {
+	u.record("Follow", username)
+	if u.FollowFunc != nil {
+		return u.FollowFunc(ctx, username)
+	}
+	return nil, &NotStubbedError{Method: "Users.Follow"}
+}
+
+// Unfollow calls UnfollowWithContext with context.Background().
+func (u *Users) Unfollow(username string) (*unsplash.Response, error) {
+	return u.UnfollowWithContext(context.Background(), username)
+}
+
+// UnfollowWithContext records the call and calls UnfollowFunc.
+func (u *Users) UnfollowWithContext(ctx context.Context, username string) (*unsplash.Response, error) {
+	u.record("Unfollow", username)
+	if u.UnfollowFunc != nil {
+		return u.UnfollowFunc(ctx, username)
+	}
+	return nil, &NotStubbedError{Method: "Users.Unfollow"}
+}
+
+// Statistics calls StatisticsWithContext with context.Background().
+func (u *Users) Statistics(username string, opt *unsplash.StatsOpt) (*unsplash.UserStatistics, *unsplash.Response, error) {
+	return u.StatisticsWithContext(context.Background(), username, opt)
+}
+
+// StatisticsWithContext records the call and calls StatisticsFunc.
+func (u *Users) StatisticsWithContext(ctx context.Context, username string, opt *unsplash.StatsOpt) (*unsplash.UserStatistics, *unsplash.Response, error) {
+	u.record("Statistics", username, opt)
+	if u.StatisticsFunc != nil {
+		return u.StatisticsFunc(ctx, username, opt)
+	}
+	return nil, nil, &NotStubbedError{Method: "Users.Statistics"}
+}
+
+// Search is a mock unsplash.SearchAPI.
+// Methods without a Func return a *NotStubbedError.
+type Search struct {
+	Recorder
+
+	UsersFunc             func(ctx context.Context, opt *unsplash.SearchOpt) (*unsplash.UserSearchResult, *unsplash.Response, error)
+	UsersPagerFunc        func(ctx context.Context, opt *unsplash.SearchOpt, pagerOpt *unsplash.PagerOpt) *unsplash.UserPager
+	PhotosFunc            func(ctx context.Context, opt *unsplash.SearchOpt) (*unsplash.PhotoSearchResult, *unsplash.Response, error)
+	PhotosPagerFunc       func(ctx context.Context, opt *unsplash.SearchOpt, pagerOpt *unsplash.PagerOpt) *unsplash.PhotoPager
+	FilterPhotosFunc      func(ctx context.Context, opt *unsplash.PhotoSearchOpt) (*unsplash.PhotoSearchResult, *unsplash.Response, error)
+	FilterPhotosPagerFunc func(ctx context.Context, opt *unsplash.PhotoSearchOpt, pagerOpt *unsplash.PagerOpt) *unsplash.PhotoPager
+	CollectionsFunc       func(ctx context.Context, opt *unsplash.SearchOpt) (*unsplash.CollectionSearchResult, *unsplash.Response, error)
+	CollectionsPagerFunc  func(ctx context.Context, opt *unsplash.SearchOpt, pagerOpt *unsplash.PagerOpt) *unsplash.CollectionPager
+}
+
+// Users calls UsersWithContext with context.Background().
+func (s *Search) Users(opt *unsplash.SearchOpt) (*unsplash.UserSearchResult, *unsplash.Response, error) {
+	return s.UsersWithContext(context.Background(), opt)
+}
+
+// UsersWithContext records the call and calls UsersFunc.
+func (s *Search) UsersWithContext(ctx context.Context, opt *unsplash.SearchOpt) (*unsplash.UserSearchResult, *unsplash.Response, error) {
+	s.record("Users", opt)
+	if s.UsersFunc != nil {
+		return s.UsersFunc(ctx, opt)
+	}
+	return nil, nil, &NotStubbedError{Method: "Search.Users"}
+}
+
+// UsersPager calls UsersPagerFunc. If it is nil, the pager requests its pages
+// from UsersWithContext.
+func (s *Search) UsersPager(ctx context.Context, opt *unsplash.SearchOpt, pagerOpt *unsplash.PagerOpt) *unsplash.UserPager {
+	s.record("UsersPager", opt, pagerOpt)
+	if s.UsersPagerFunc != nil {
+		return s.UsersPagerFunc(ctx, opt, pagerOpt)
+	}
+	start := 0
+	if opt != nil {
+		start = opt.Page
+	}
+	return unsplash.NewUserPager(ctx, start, func(ctx context.Context, page int) (*[]unsplash.User, *unsplash.Response, error) {
+		var pageOpt unsplash.SearchOpt
+		if opt != nil {
+			pageOpt = *opt
+		}
+		pageOpt.Page = page
+		result, resp, err := s.UsersWithContext(ctx, &pageOpt)
+		if err != nil || result == nil {
+			return nil, resp, err
+		}
+		return result.Results, resp, nil
+	}, pagerOpt)
+}
+
+// Photos calls PhotosWithContext with context.Background().
+func (s *Search) Photos(opt *unsplash.SearchOpt) (*unsplash.PhotoSearchResult, *unsplash.Response, error) {
+	return s.PhotosWithContext(context.Background(), opt)
+}
+
+// PhotosWithContext records the call and calls PhotosFunc.
+func (s *Search) PhotosWithContext(ctx context.Context, opt *unsplash.SearchOpt) (*unsplash.PhotoSearchResult, *unsplash.Response, error) {
+	s.record("Photos", opt)
+	if s.PhotosFunc != nil {
+		return s.PhotosFunc(ctx, opt)
+	}
+	return nil, nil, &NotStubbedError{Method: "Search.Photos"}
+}
+
+// PhotosPager calls PhotosPagerFunc. If it is nil, the pager requests its pages
+// from PhotosWithContext.
+func (s *Search) PhotosPager(ctx context.Context, opt *unsplash.SearchOpt, pagerOpt *unsplash.PagerOpt) *unsplash.PhotoPager {
+	s.record("PhotosPager", opt, pagerOpt)
+	if s.PhotosPagerFunc != nil {
+		return s.PhotosPagerFunc(ctx, opt, pagerOpt)
+	}
+	start := 0
+	if opt != nil {
+		start = opt.Page
+	}
+	return unsplash.NewPhotoPager(ctx, start, func(ctx context.Context, page int) (*[]unsplash.Photo, *unsplash.Response, error) {
+		var pageOpt unsplash.SearchOpt
+		if opt != nil {
+			pageOpt = *opt
+		}
+		pageOpt.Page = page
+		result, resp, err := s.PhotosWithContext(ctx, &pageOpt)
+		if err != nil || result == nil {
+			return nil, resp, err
+		}
+		return result.Results, resp, nil
+	}, pagerOpt)
+}
+
+// FilterPhotos calls FilterPhotosWithContext with context.Background().
+func (s *Search) FilterPhotos(opt *unsplash.PhotoSearchOpt) (*unsplash.PhotoSearchResult, *unsplash.Response, error) {
+	return s.FilterPhotosWithContext(context.Background(), opt)
+}
+
+// FilterPhotosWithContext records the call and calls FilterPhotosFunc.
+func (s *Search) FilterPhotosWithContext(ctx context.Context, opt *unsplash.PhotoSearchOpt) (*unsplash.PhotoSearchResult, *unsplash.Response, error) {
+	s.record("FilterPhotos", opt)
+	if s.FilterPhotosFunc != nil {
+		return s.FilterPhotosFunc(ctx, opt)
+	}
+	return nil, nil, &NotStubbedError{Method: "Search.FilterPhotos"}
+}
+
+// FilterPhotosPager calls FilterPhotosPagerFunc. If it is nil, the pager requests its pages
+// from FilterPhotosWithContext.
+func (s *Search) FilterPhotosPager(ctx context.Context, opt *unsplash.PhotoSearchOpt, pagerOpt *unsplash.PagerOpt) *unsplash.PhotoPager {
+	s.record("FilterPhotosPager", opt, pagerOpt)
+	if s.FilterPhotosPagerFunc != nil {
+		return s.FilterPhotosPagerFunc(ctx, opt, pagerOpt)
+	}
+	start := 0
+	if opt != nil {
+		start = opt.Page
+	}
+	return unsplash.NewPhotoPager(ctx, start, func(ctx context.Context, page int) (*[]unsplash.Photo, *unsplash.Response, error) {
+		var pageOpt unsplash.PhotoSearchOpt
+		if opt != nil {
+			pageOpt = *opt
+		}
+		pageOpt.Page = page
+		result, resp, err := s.FilterPhotosWithContext(ctx, &pageOpt)
+		if err != nil || result == nil {
+			return nil, resp, err
+		}
+		return result.Results, resp, nil
+	}, pagerOpt)
+}
+
+// Collections calls CollectionsWithContext with context.Background().
+func (s *Search) Collections(opt *unsplash.SearchOpt) (*unsplash.CollectionSearchResult, *unsplash.Response, error) {
+	return s.CollectionsWithContext(context.Background(), opt)
+}
+
+// CollectionsWithContext records the call and calls CollectionsFunc.
+func (s *Search) CollectionsWithContext(ctx context.Context, opt *unsplash.SearchOpt) (*unsplash.CollectionSearchResult, *unsplash.Response, error) {
+	s.record("Collections", opt)
+	if s.CollectionsFunc != nil {
+		return s.CollectionsFunc(ctx, opt)
+	}
+	return nil, nil, &NotStubbedError{Method: "Search.Collections"}
+}
+
+// CollectionsPager calls CollectionsPagerFunc. If it is nil, the pager requests its pages
+// from CollectionsWithContext.
+func (s *Search) CollectionsPager(ctx context.Context, opt *unsplash.SearchOpt, pagerOpt *unsplash.PagerOpt) *unsplash.CollectionPager {
+	s.record("CollectionsPager", opt, pagerOpt)
+	if s.CollectionsPagerFunc != nil {
+		return s.CollectionsPagerFunc(ctx, opt, pagerOpt)
+	}
+	start := 0
+	if opt != nil {
+		start = opt.Page
+	}
+	return unsplash.NewCollectionPager(ctx, start, func(ctx context.Context, page int) (*[]unsplash.Collection, *unsplash.Response, error) {
+		var pageOpt unsplash.SearchOpt
+		if opt != nil {
+			pageOpt = *opt
+		}
+		pageOpt.Page = page
+		result, resp, err := s.CollectionsWithContext(ctx, &pageOpt)
+		if err != nil || result == nil {
+			return nil, resp, err
+		}
+		return result.Results, resp, nil
+	}, pagerOpt)
+}
+
+// Topics is a mock unsplash.TopicsAPI.
+// Methods without a Func return a *NotStubbedError.
+type Topics struct {
+	Recorder
+
+	AllFunc         func(ctx context.Context, opt *unsplash.TopicListOpt) (*[]unsplash.Topic, *unsplash.Response, error)
+	TopicFunc       func(ctx context.Context, idOrSlug string) (*unsplash.Topic, *unsplash.Response, error)
+	PhotosFunc      func(ctx context.Context, idOrSlug string, opt *unsplash.TopicPhotosOpt) (*[]unsplash.Photo, *unsplash.Response, error)
+	PhotosPagerFunc func(ctx context.Context, idOrSlug string, opt *unsplash.TopicPhotosOpt, pagerOpt *unsplash.PagerOpt) *unsplash.PhotoPager
+}
+
+// All calls AllWithContext with context.Background().
+func (t *Topics) All(opt *unsplash.TopicListOpt) (*[]unsplash.Topic, *unsplash.Response, error) {
+	return t.AllWithContext(context.Background(), opt)
+}
+
+// AllWithContext records the call and calls AllFunc.
+func (t *Topics) AllWithContext(ctx context.Context, opt *unsplash.TopicListOpt) (*[]unsplash.Topic, *unsplash.Response, error) {
+	t.record("All", opt)
+	if t.AllFunc != nil {
+		return t.AllFunc(ctx, opt)
+	}
+	return nil, nil, &NotStubbedError{Method: "Topics.All"}
+}
+
+// Topic calls TopicWithContext with context.Background().
+func (t *Topics) Topic(idOrSlug string) (*unsplash.Topic, *unsplash.Response, error) {
+	return t.TopicWithContext(context.Background(), idOrSlug)
+}
+
+// TopicWithContext records the call and calls TopicFunc.
+func (t *Topics) TopicWithContext(ctx context.Context, idOrSlug string) (*unsplash.Topic, *unsplash.Response, error) {
+	t.record("Topic", idOrSlug)
+	if t.TopicFunc != nil {
+		return t.TopicFunc(ctx, idOrSlug)
+	}
+	return nil, nil, &NotStubbedError{Method: "Topics.Topic"}
+}
+
+// Photos calls PhotosWithContext with context.Background().
+func (t *Topics) Photos(idOrSlug string, opt *unsplash.TopicPhotosOpt) (*[]unsplash.Photo, *unsplash.Response, error) {
+	return t.PhotosWithContext(context.Background(), idOrSlug, opt)
+}
+
+// PhotosWithContext records the call and calls PhotosFunc.
+func (t *Topics) PhotosWithContext(ctx context.Context, idOrSlug string, opt *unsplash.TopicPhotosOpt) (*[]unsplash.Photo, *unsplash.Response, error) {
+	t.record("Photos", idOrSlug, opt)
+	if t.PhotosFunc != nil {
+		return t.PhotosFunc(ctx, idOrSlug, opt)
+	}
+	return nil, nil, &NotStubbedError{Method: "Topics.Photos"}
+}
+
+// PhotosPager calls PhotosPagerFunc. If it is nil, the pager requests its pages
+// from PhotosWithContext.
+func (t *Topics) PhotosPager(ctx context.Context, idOrSlug string, opt *unsplash.TopicPhotosOpt, pagerOpt *unsplash.PagerOpt) *unsplash.PhotoPager {
+	t.record("PhotosPager", idOrSlug, opt, pagerOpt)
+	if t.PhotosPagerFunc != nil {
+		return t.PhotosPagerFunc(ctx, idOrSlug, opt, pagerOpt)
+	}
+	start := 0
+	if opt != nil {
+		start = opt.Page
+	}
+	return unsplash.NewPhotoPager(ctx, start, func(ctx context.Context, page int) (*[]unsplash.Photo, *unsplash.Response, error) {
+		var pageOpt unsplash.TopicPhotosOpt
+		if opt != nil {
+			pageOpt = *opt
+		}
+		pageOpt.Page = page
+		return t.PhotosWithContext(ctx, idOrSlug, &pageOpt)
+	}, pagerOpt)
+}
+
+// Client is a mock unsplash.API. Its services are the mocks in the
+// Photos, Collections, Users, Search and Topics fields.
+// Methods without a Func return a *NotStubbedError.
+type Client struct {
+	Recorder
+
+	Photos      *Photos
+	Collections *Collections
+	Users       *Users
+	Search      *Search
+	Topics      *Topics
+
+	CurrentUserFunc       func(ctx context.Context) (*unsplash.User, *unsplash.Response, error)
+	UpdateCurrentUserFunc func(ctx context.Context, updateInfo *unsplash.UserUpdateInfo) (*unsplash.User, *unsplash.Response, error)
+	StatsFunc             func(ctx context.Context) (*unsplash.GlobalStats, *unsplash.Response, error)
+	TotalStatsFunc        func(ctx context.Context) (*unsplash.GlobalStats, *unsplash.Response, error)
+	MonthStatsFunc        func(ctx context.Context) (*unsplash.MonthStats, *unsplash.Response, error)
+}
+
+// CurrentUser calls CurrentUserWithContext with context.Background().
+func (c *Client) CurrentUser() (*unsplash.User, *unsplash.Response, error) {
+	return c.CurrentUserWithContext(context.Background())
+}
+
+// CurrentUserWithContext records the call and calls CurrentUserFunc.
+func (c *Client) CurrentUserWithContext(ctx context.Context) (*unsplash.User, *unsplash.Response, error) {
+	c.record("CurrentUser")
+	if c.CurrentUserFunc != nil {
+		return c.CurrentUserFunc(ctx)
+	}
+	return nil, nil, &NotStubbedError{Method: "Client.CurrentUser"}
+}
+
+// UpdateCurrentUser calls UpdateCurrentUserWithContext with context.Background().
+func (c *Client) UpdateCurrentUser(updateInfo *unsplash.UserUpdateInfo) (*unsplash.User, *unsplash.Response, error) {
+	return c.UpdateCurrentUserWithContext(context.Background(), updateInfo)
+}
+
+// UpdateCurrentUserWithContext records the call and calls UpdateCurrentUserFunc.
+func (c *Client) UpdateCurrentUserWithContext(ctx context.Context, updateInfo *unsplash.UserUpdateInfo) (*unsplash.User, *unsplash.Response, error) {
+	c.record("UpdateCurrentUser", updateInfo)
+	if c.UpdateCurrentUserFunc != nil {
+		return c.UpdateCurrentUserFunc(ctx, updateInfo)
+	}
+	return nil, nil, &NotStubbedError{Method: "Client.UpdateCurrentUser"}
+}
+
+// Stats calls StatsWithContext with context.Background().
+func (c *Client) Stats() (*unsplash.GlobalStats, *unsplash.Response, error) {
+	return c.StatsWithContext(context.Background())
+}
+
+// StatsWithContext records the call and calls StatsFunc.
+func (c *Client) StatsWithContext(ctx context.Context) (*unsplash.GlobalStats, *unsplash.Response, error) {
+	c.record("Stats")
+	if c.StatsFunc != nil {
+		return c.StatsFunc(ctx)
+	}
+	return nil, nil, &NotStubbedError{Method: "Client.Stats"}
+}
+
+// TotalStats calls TotalStatsWithContext with context.Background().
+func (c *Client) TotalStats() (*unsplash.GlobalStats, *unsplash.Response, error) {
+	return c.TotalStatsWithContext(context.Background())
+}
+
+// TotalStatsWithContext records the call and calls TotalStatsFunc.
+func (c *Client) TotalStatsWithContext(ctx context.Context) (*unsplash.GlobalStats, *unsplash.Response, error) {
+	c.record("TotalStats")
+	if c.TotalStatsFunc != nil {
+		return c.TotalStatsFunc(ctx)
+	}
+	return nil, nil, &NotStubbedError{Method: "Client.TotalStats"}
+}
+
+// MonthStats calls MonthStatsWithContext with context.Background().
+func (c *Client) MonthStats() (*unsplash.MonthStats, *unsplash.Response, error) {
+	return c.MonthStatsWithContext(context.Background())
+}
+
+// MonthStatsWithContext records the call and calls MonthStatsFunc.
+func (c *Client) MonthStatsWithContext(ctx context.Context) (*unsplash.MonthStats, *unsplash.Response, error) {
+	c.record("MonthStats")
+	if c.MonthStatsFunc != nil {
+		return c.MonthStatsFunc(ctx)
+	}
+	return nil, nil, &NotStubbedError{Method: "Client.MonthStats"}
+}
+
+// PhotosAPI returns the Photos mock.
+func (c *Client) PhotosAPI() unsplash.PhotosAPI {
+	return c.Photos
+}
+
+// CollectionsAPI returns the Collections mock.
+func (c *Client) CollectionsAPI() unsplash.CollectionsAPI {
+	return c.Collections
+}
+
+// UsersAPI returns the Users mock.
+func (c *Client) UsersAPI() unsplash.UsersAPI {
+	return c.Users
+}
+
+// SearchAPI returns the Search mock.
+func (c *Client) SearchAPI() unsplash.SearchAPI {
+	return c.Search
+}
+
+// TopicsAPI returns the Topics mock.
+func (c *Client) TopicsAPI() unsplash.TopicsAPI {
+	return c.Topics
+}
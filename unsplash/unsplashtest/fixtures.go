@@ -0,0 +1,155 @@
+// Copyright (c) 2017 Hardik Bagdi <hbagdi1@binghamton.edu>
+//
+// MIT License
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package unsplashtest
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// User is a user known to the fake server.
+type User struct {
+	ID                string
+	Username          string
+	FirstName         string
+	LastName          string
+	Bio               string
+	Location          string
+	PortfolioURL      string
+	InstagramUsername string
+}
+
+// Name returns the full name of the user.
+func (u *User) Name() string {
+	return strings.TrimSpace(u.FirstName + " " + u.LastName)
+}
+
+// Photo is a photo known to the fake server.
+type Photo struct {
+	ID             string
+	Username       string
+	CreatedAt      time.Time
+	Width          int
+	Height         int
+	Color          string
+	BlurHash       string
+	Description    string
+	AltDescription string
+	Tags           []string
+	Likes          int
+	Views          int
+	Downloads      int
+}
+
+// Collection is a collection known to the fake server.
+type Collection struct {
+	ID          int
+	Username    string
+	Title       string
+	Description string
+	Private     bool
+	Featured    bool
+	PublishedAt time.Time
+	PhotoIDs    []string
+}
+
+// Fixture identifiers seeded by NewServer.
+const (
+	// CurrentUser is the user the fake server authenticates bearer tokens as.
+	CurrentUser = "gopher"
+	// SeededPhotos is the number of photos seeded by NewServer.
+	SeededPhotos = 40
+	// SeededCollections is the number of collections seeded by NewServer.
+	SeededCollections = 6
+)
+
+var seedUsers = []User{
+	{ID: "user-gopher", Username: CurrentUser, FirstName: "Go", LastName: "Gopher",
+		Bio: "Burrowing since 2009", Location: "Mountain View", InstagramUsername: "gopher"},
+	{ID: "user-ferris", Username: "ferris", FirstName: "Ferris", LastName: "Crab",
+		Bio: "Sideways photographer", Location: "Berlin"},
+	{ID: "user-duke", Username: "duke", FirstName: "Duke",
+		Bio: "Coffee and landscapes", Location: "Santa Clara"},
+	{ID: "user-octocat", Username: "octocat", FirstName: "Mona", LastName: "Octocat",
+		Bio: "Eight arms, one camera", Location: "San Francisco"},
+}
+
+var seedSubjects = []struct {
+	description string
+	tags        []string
+	color       string
+	blurHash    string
+}{
+	{"Mountain lake at dawn", []string{"mountain", "lake", "nature"}, "#a6c0d9", "LEHV6nWB2yk8pyo0adR*.7kCMdnj"},
+	{"City skyline at night", []string{"city", "night", "architecture"}, "#0c1926", "L35#I^%M00M{00M{~qof00ay?bj["},
+	{"Forest trail in autumn", []string{"forest", "autumn", "nature"}, "#c07326", "LGF5]+Yk^6#M@-5c,1J5@[or[Q6."},
+	{"Ocean waves on the shore", []string{"ocean", "beach", "water"}, "#2673a6", "L6PZfSi_.AyE_3t7t7R**0o#DgR4"},
+	{"Desert dunes under a blue sky", []string{"desert", "sand", "nature"}, "#d9a673", "LKO2?U%2Tw=w]~RBVZRi};RPxuwH"},
+}
+
+// seed fills the server with the default fixtures.
+func (s *Server) seed() {
+	for i := range seedUsers {
+		s.users = append(s.users, seedUsers[i])
+	}
+	base := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+	sizes := [][2]int{{6000, 4000}, {4000, 6000}, {5000, 5000}}
+	for i := 0; i < SeededPhotos; i++ {
+		subject := seedSubjects[i%len(seedSubjects)]
+		size := sizes[i%len(sizes)]
+		s.photos = append(s.photos, Photo{
+			ID:             fmt.Sprintf("photo-%02d", i+1),
+			Username:       seedUsers[i%len(seedUsers)].Username,
+			CreatedAt:      base.Add(time.Duration(i) * 24 * time.Hour),
+			Width:          size[0],
+			Height:         size[1],
+			Color:          subject.color,
+			BlurHash:       subject.blurHash,
+			Description:    fmt.Sprintf("%v #%d", subject.description, i+1),
+			AltDescription: strings.ToLower(subject.description),
+			Tags:           subject.tags,
+			Likes:          (i * 37) % 101,
+			Views:          1000 + i*250,
+			Downloads:      100 + i*25,
+		})
+	}
+	for i := 0; i < SeededCollections; i++ {
+		var photoIDs []string
+		for j := 0; j < 5; j++ {
+			photoIDs = append(photoIDs, s.photos[(i*5+j)%len(s.photos)].ID)
+		}
+		s.collections = append(s.collections, Collection{
+			ID:          i + 1,
+			Username:    seedUsers[i%len(seedUsers)].Username,
+			Title:       fmt.Sprintf("%v collection", seedSubjects[i%len(seedSubjects)].tags[0]),
+			Description: fmt.Sprintf("Photos of %v", seedSubjects[i%len(seedSubjects)].tags[0]),
+			Private:     i == 4, // owned by CurrentUser
+			Featured:    i%2 == 0,
+			PublishedAt: base.Add(time.Duration(i) * 7 * 24 * time.Hour),
+			PhotoIDs:    photoIDs,
+		})
+	}
+	s.nextCollectionID = SeededCollections + 1
+}
@@ -0,0 +1,906 @@
+// Copyright (c) 2017 Hardik Bagdi <hbagdi1@binghamton.edu>
+//
+// MIT License
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package unsplashtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	defaultPerPage = 10
+	maxPerPage     = 30
+	imageSize      = 64 << 10
+)
+
+type object map[string]interface{}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeErrors(w http.ResponseWriter, status int, errs ...string) {
+	writeJSON(w, status, object{"errors": errs})
+}
+
+// route dispatches an authenticated API request.
+// bearer tells whether the request acts on behalf of CurrentUser.
+func (s *Server) route(w http.ResponseWriter, r *http.Request, bearer bool) {
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	write := r.Method != http.MethodGet
+	if (write || parts[0] == "me") && !bearer {
+		writeErrors(w, http.StatusUnauthorized, "OAuth error: The access token is invalid")
+		return
+	}
+	switch parts[0] {
+	case "me":
+		s.serveMe(w, r, parts)
+	case "stats":
+		s.serveStats(w, r, parts)
+	case "photos":
+		s.servePhotos(w, r, parts)
+	case "collections":
+		s.serveCollections(w, r, parts)
+	case "users":
+		s.serveUsers(w, r, parts)
+	case "search":
+		s.serveSearch(w, r, parts)
+	default:
+		writeErrors(w, http.StatusNotFound, "Couldn't find the requested resource")
+	}
+}
+
+func notFound(w http.ResponseWriter, kind string) {
+	writeErrors(w, http.StatusNotFound, fmt.Sprintf("Couldn't find %v", kind))
+}
+
+func methodNotAllowed(w http.ResponseWriter) {
+	writeErrors(w, http.StatusMethodNotAllowed, "Method not allowed")
+}
+
+// page returns the bounds of the requested page of n items and sets the
+// Link, X-Total and X-Per-Page headers.
+func (s *Server) page(w http.ResponseWriter, r *http.Request, n int) (int, int) {
+	page, perPage, pages := pageParams(r, n)
+	w.Header().Set("X-Total", strconv.Itoa(n))
+	w.Header().Set("X-Per-Page", strconv.Itoa(perPage))
+	link := func(p int, rel string) string {
+		query := r.URL.Query()
+		query.Set("page", strconv.Itoa(p))
+		return fmt.Sprintf(`<%v%v?%v>; rel="%v"`, s.URL, r.URL.Path, query.Encode(), rel)
+	}
+	var links []string
+	if page > 1 {
+		links = append(links, link(1, "first"), link(page-1, "prev"))
+	}
+	if page < pages {
+		links = append(links, link(pages, "last"), link(page+1, "next"))
+	}
+	if len(links) != 0 {
+		w.Header().Set("Link", strings.Join(links, ", "))
+	}
+	return bounds(page, perPage, n)
+}
+
+func pageParams(r *http.Request, n int) (page, perPage, pages int) {
+	page, _ = strconv.Atoi(r.URL.Query().Get("page"))
+	if page < 1 {
+		page = 1
+	}
+	perPage, _ = strconv.Atoi(r.URL.Query().Get("per_page"))
+	if perPage < 1 {
+		perPage = defaultPerPage
+	}
+	if perPage > maxPerPage {
+		perPage = maxPerPage
+	}
+	pages = (n + perPage - 1) / perPage
+	return page, perPage, pages
+}
+
+func bounds(page, perPage, n int) (int, int) {
+	start := (page - 1) * perPage
+	if start > n {
+		start = n
+	}
+	end := start + perPage
+	if end > n {
+		end = n
+	}
+	return start, end
+}
+
+// searchResult writes a page of a search result.
+func (s *Server) searchResult(w http.ResponseWriter, r *http.Request, results []object) {
+	page, perPage, pages := pageParams(r, len(results))
+	start, end := bounds(page, perPage, len(results))
+	writeJSON(w, http.StatusOK, object{
+		"total":       len(results),
+		"total_pages": pages,
+		"results":     results[start:end],
+	})
+}
+
+func (s *Server) findUser(username string) *User {
+	for i := range s.users {
+		if s.users[i].Username == username {
+			return &s.users[i]
+		}
+	}
+	return nil
+}
+
+func (s *Server) findPhoto(id string) *Photo {
+	for i := range s.photos {
+		if s.photos[i].ID == id {
+			return &s.photos[i]
+		}
+	}
+	return nil
+}
+
+func (s *Server) findCollection(id string) *Collection {
+	for i := range s.collections {
+		if strconv.Itoa(s.collections[i].ID) == id {
+			return &s.collections[i]
+		}
+	}
+	return nil
+}
+
+func (s *Server) imageURL(p *Photo) string {
+	return fmt.Sprintf("%v/images/%v?ixid=M3wxMjA3fDB8MXxhbGx8fHx8&ixlib=rb-4.0.3", s.URL, p.ID)
+}
+
+func (s *Server) renderUser(u *User) object {
+	photos, likes, collections := 0, len(s.likes[u.Username]), 0
+	for i := range s.photos {
+		if s.photos[i].Username == u.Username {
+			photos++
+		}
+	}
+	for i := range s.collections {
+		if s.collections[i].Username == u.Username {
+			collections++
+		}
+	}
+	self := fmt.Sprintf("%v/users/%v", s.URL, u.Username)
+	avatar := fmt.Sprintf("%v/images/profile-%v?ixlib=rb-4.0.3", s.URL, u.Username)
+	var portfolio interface{}
+	if u.PortfolioURL != "" {
+		portfolio = u.PortfolioURL
+	}
+	return object{
+		"id":                 u.ID,
+		"username":           u.Username,
+		"name":               u.Name(),
+		"first_name":         u.FirstName,
+		"last_name":          u.LastName,
+		"bio":                u.Bio,
+		"location":           u.Location,
+		"portfolio_url":      portfolio,
+		"instagram_username": u.InstagramUsername,
+		"total_photos":       photos,
+		"total_likes":        likes,
+		"total_collections":  collections,
+		"followed_by_user":   false,
+		"profile_image": object{
+			"small":  avatar + "&w=32&h=32",
+			"medium": avatar + "&w=64&h=64",
+			"large":  avatar + "&w=128&h=128",
+		},
+		"links": object{
+			"self":      self,
+			"html":      "https://unsplash.com/@" + u.Username,
+			"photos":    self + "/photos",
+			"likes":     self + "/likes",
+			"portfolio": self + "/portfolio",
+		},
+	}
+}
+
+func (s *Server) renderPhoto(p *Photo) object {
+	var user interface{}
+	if u := s.findUser(p.Username); u != nil {
+		user = s.renderUser(u)
+	}
+	var tags []object
+	for _, tag := range p.Tags {
+		tags = append(tags, object{"type": "search", "title": tag})
+	}
+	raw := s.imageURL(p)
+	self := fmt.Sprintf("%v/photos/%v", s.URL, p.ID)
+	return object{
+		"id":              p.ID,
+		"slug":            p.ID,
+		"created_at":      p.CreatedAt.Format(time.RFC3339),
+		"updated_at":      p.CreatedAt.Format(time.RFC3339),
+		"width":           p.Width,
+		"height":          p.Height,
+		"color":           p.Color,
+		"blur_hash":       p.BlurHash,
+		"description":     p.Description,
+		"alt_description": p.AltDescription,
+		"likes":           p.Likes,
+		"views":           p.Views,
+		"downloads":       p.Downloads,
+		"liked_by_user":   s.likes[CurrentUser][p.ID],
+		"tags":            tags,
+		"user":            user,
+		"urls": object{
+			"raw":     raw,
+			"full":    raw + "&q=85&fm=jpg&crop=entropy&cs=srgb",
+			"regular": raw + "&q=80&fm=jpg&crop=entropy&cs=tinysrgb&w=1080&fit=max",
+			"small":   raw + "&q=80&fm=jpg&crop=entropy&cs=tinysrgb&w=400&fit=max",
+			"thumb":   raw + "&q=80&fm=jpg&crop=entropy&cs=tinysrgb&w=200&fit=max",
+		},
+		"links": object{
+			"self":              self,
+			"html":              "https://unsplash.com/photos/" + p.ID,
+			"download":          "https://unsplash.com/photos/" + p.ID + "/download",
+			"download_location": self + "/download?ixid=M3wxMjA3fDB8MXxhbGx8fHx8",
+		},
+	}
+}
+
+func (s *Server) renderCollection(c *Collection) object {
+	var user, cover interface{}
+	if u := s.findUser(c.Username); u != nil {
+		user = s.renderUser(u)
+	}
+	if len(c.PhotoIDs) != 0 {
+		if p := s.findPhoto(c.PhotoIDs[0]); p != nil {
+			cover = s.renderPhoto(p)
+		}
+	}
+	self := fmt.Sprintf("%v/collections/%v", s.URL, c.ID)
+	return object{
+		"id":           c.ID,
+		"title":        c.Title,
+		"description":  c.Description,
+		"published_at": c.PublishedAt.Format(time.RFC3339),
+		"featured":     c.Featured,
+		"curated":      false,
+		"total_photos": len(c.PhotoIDs),
+		"private":      c.Private,
+		"share_key":    fmt.Sprintf("sharekey%d", c.ID),
+		"cover_photo":  cover,
+		"user":         user,
+		"links": object{
+			"self":    self,
+			"html":    fmt.Sprintf("https://unsplash.com/collections/%v", c.ID),
+			"photos":  self + "/photos",
+			"related": self + "/related",
+		},
+	}
+}
+
+func (s *Server) renderPhotos(photos []*Photo) []object {
+	rendered := make([]object, 0, len(photos))
+	for _, p := range photos {
+		rendered = append(rendered, s.renderPhoto(p))
+	}
+	return rendered
+}
+
+func (s *Server) renderCollections(collections []*Collection) []object {
+	rendered := make([]object, 0, len(collections))
+	for _, c := range collections {
+		rendered = append(rendered, s.renderCollection(c))
+	}
+	return rendered
+}
+
+// pagePhotos writes a page of photos.
+func (s *Server) pagePhotos(w http.ResponseWriter, r *http.Request, photos []*Photo) {
+	start, end := s.page(w, r, len(photos))
+	writeJSON(w, http.StatusOK, s.renderPhotos(photos[start:end]))
+}
+
+// pageCollections writes a page of collections.
+func (s *Server) pageCollections(w http.ResponseWriter, r *http.Request, collections []*Collection) {
+	start, end := s.page(w, r, len(collections))
+	writeJSON(w, http.StatusOK, s.renderCollections(collections[start:end]))
+}
+
+// visible reports whether c can be seen by the requester.
+func visible(c *Collection, bearer bool) bool {
+	return !c.Private || (bearer && c.Username == CurrentUser)
+}
+
+func orientationOf(p *Photo) string {
+	switch {
+	case p.Width > p.Height:
+		return "landscape"
+	case p.Width < p.Height:
+		return "portrait"
+	default:
+		return "squarish"
+	}
+}
+
+// filterOrientation drops the photos not matching the orientation parameter.
+func filterOrientation(r *http.Request, photos []*Photo) ([]*Photo, bool) {
+	orientation := r.URL.Query().Get("orientation")
+	switch orientation {
+	case "":
+		return photos, true
+	case "landscape", "portrait", "squarish":
+	default:
+		return nil, false
+	}
+	var filtered []*Photo
+	for _, p := range photos {
+		if orientationOf(p) == orientation {
+			filtered = append(filtered, p)
+		}
+	}
+	return filtered, true
+}
+
+// sortPhotos orders photos according to the order_by parameter.
+func sortPhotos(r *http.Request, photos []*Photo) bool {
+	var less func(a, b *Photo) bool
+	switch r.URL.Query().Get("order_by") {
+	case "", "latest":
+		less = func(a, b *Photo) bool { return a.CreatedAt.After(b.CreatedAt) }
+	case "oldest":
+		less = func(a, b *Photo) bool { return a.CreatedAt.Before(b.CreatedAt) }
+	case "popular":
+		less = func(a, b *Photo) bool { return a.Likes > b.Likes }
+	default:
+		return false
+	}
+	for i := 1; i < len(photos); i++ {
+		for j := i; j > 0 && less(photos[j], photos[j-1]); j-- {
+			photos[j], photos[j-1] = photos[j-1], photos[j]
+		}
+	}
+	return true
+}
+
+func (s *Server) allPhotos() []*Photo {
+	photos := make([]*Photo, 0, len(s.photos))
+	for i := range s.photos {
+		photos = append(photos, &s.photos[i])
+	}
+	return photos
+}
+
+func (s *Server) photosOf(ids []string) []*Photo {
+	var photos []*Photo
+	for _, id := range ids {
+		if p := s.findPhoto(id); p != nil {
+			photos = append(photos, p)
+		}
+	}
+	return photos
+}
+
+func contains(haystack, needle string) bool {
+	return strings.Contains(strings.ToLower(haystack), strings.ToLower(needle))
+}
+
+func (s *Server) serveMe(w http.ResponseWriter, r *http.Request, parts []string) {
+	me := s.findUser(CurrentUser)
+	if len(parts) != 1 || me == nil {
+		notFound(w, "User")
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+	case http.MethodPut:
+		query := r.URL.Query()
+		for param, field := range map[string]*string{
+			"username":           &me.Username,
+			"first_name":         &me.FirstName,
+			"last_name":          &me.LastName,
+			"bio":                &me.Bio,
+			"location":           &me.Location,
+			"url":                &me.PortfolioURL,
+			"instagram_username": &me.InstagramUsername,
+		} {
+			if val := query.Get(param); val != "" {
+				*field = val
+			}
+		}
+	default:
+		methodNotAllowed(w)
+		return
+	}
+	writeJSON(w, http.StatusOK, s.renderUser(me))
+}
+
+func (s *Server) serveStats(w http.ResponseWriter, r *http.Request, parts []string) {
+	if len(parts) != 2 {
+		notFound(w, "Stats")
+		return
+	}
+	downloads, views, likes := 0, 0, 0
+	for _, p := range s.photos {
+		downloads += p.Downloads
+		views += p.Views
+		likes += p.Likes
+	}
+	switch parts[1] {
+	case "total":
+		writeJSON(w, http.StatusOK, object{
+			"photos":               len(s.photos),
+			"downloads":            downloads,
+			"views":                views,
+			"likes":                likes,
+			"photographers":        len(s.users),
+			"pixels":               0,
+			"downloads_per_second": 0,
+			"views_per_second":     0,
+			"developers":           1,
+			"applications":         1,
+			"requests":             len(s.requests),
+		})
+	case "month":
+		writeJSON(w, http.StatusOK, object{
+			"downloads":         downloads / 12,
+			"views":             views / 12,
+			"likes":             likes / 12,
+			"new_photos":        len(s.photos) / 12,
+			"new_photographers": 1,
+			"new_pixels":        0,
+			"new_developers":    1,
+			"new_applications":  1,
+			"new_requests":      len(s.requests),
+		})
+	default:
+		notFound(w, "Stats")
+	}
+}
+
+// historical renders the historical part of a statistics response.
+func historical(r *http.Request, total int) object {
+	resolution := r.URL.Query().Get("resolution")
+	if resolution == "" {
+		resolution = "days"
+	}
+	quantity, _ := strconv.Atoi(r.URL.Query().Get("quantity"))
+	if quantity == 0 {
+		quantity = 30
+	}
+	end := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	values := make([]object, 0, quantity)
+	for i := quantity - 1; i >= 0; i-- {
+		values = append(values, object{
+			"date":  end.AddDate(0, 0, -i).Format("2006-01-02"),
+			"value": total / quantity,
+		})
+	}
+	return object{
+		"change":     total / quantity * quantity,
+		"average":    total / quantity,
+		"resolution": resolution,
+		"quantity":   quantity,
+		"values":     values,
+	}
+}
+
+func (s *Server) servePhotos(w http.ResponseWriter, r *http.Request, parts []string) {
+	if len(parts) == 1 {
+		if r.Method != http.MethodGet {
+			methodNotAllowed(w)
+			return
+		}
+		photos := s.allPhotos()
+		if !sortPhotos(r, photos) {
+			writeErrors(w, http.StatusBadRequest, "order_by is invalid")
+			return
+		}
+		s.pagePhotos(w, r, photos)
+		return
+	}
+	if parts[1] == "random" && len(parts) == 2 {
+		s.serveRandom(w, r)
+		return
+	}
+	p := s.findPhoto(parts[1])
+	if p == nil {
+		notFound(w, "Photo")
+		return
+	}
+	switch {
+	case len(parts) == 2 && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, s.renderPhoto(p))
+	case len(parts) == 2 && r.Method == http.MethodPut:
+		if p.Username != CurrentUser {
+			writeErrors(w, http.StatusForbidden, "Access denied")
+			return
+		}
+		query := r.URL.Query()
+		if _, ok := query["description"]; ok {
+			p.Description = query.Get("description")
+		}
+		if tags := query.Get("tags"); tags != "" {
+			p.Tags = strings.Split(tags, ",")
+		}
+		writeJSON(w, http.StatusOK, s.renderPhoto(p))
+	case len(parts) == 3 && parts[2] == "statistics" && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, object{
+			"id":        p.ID,
+			"downloads": object{"total": p.Downloads, "historical": historical(r, p.Downloads)},
+			"views":     object{"total": p.Views, "historical": historical(r, p.Views)},
+			"likes":     object{"total": p.Likes, "historical": historical(r, p.Likes)},
+		})
+	case len(parts) == 3 && parts[2] == "download" && r.Method == http.MethodGet:
+		p.Downloads++
+		writeJSON(w, http.StatusOK, object{"url": s.imageURL(p)})
+	case len(parts) == 3 && parts[2] == "like":
+		liked := s.likes[CurrentUser]
+		if liked == nil {
+			liked = make(map[string]bool)
+			s.likes[CurrentUser] = liked
+		}
+		status := http.StatusOK
+		switch r.Method {
+		case http.MethodPost:
+			if !liked[p.ID] {
+				liked[p.ID] = true
+				p.Likes++
+			}
+			status = http.StatusCreated
+		case http.MethodDelete:
+			if liked[p.ID] {
+				delete(liked, p.ID)
+				p.Likes--
+			}
+		default:
+			methodNotAllowed(w)
+			return
+		}
+		writeJSON(w, status, object{"photo": s.renderPhoto(p), "user": s.renderUser(s.findUser(CurrentUser))})
+	default:
+		notFound(w, "Photo")
+	}
+}
+
+func (s *Server) serveRandom(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	photos, ok := filterOrientation(r, s.allPhotos())
+	if !ok {
+		writeErrors(w, http.StatusBadRequest, "orientation is invalid")
+		return
+	}
+	var filtered []*Photo
+	for _, p := range photos {
+		if username := query.Get("username"); username != "" && p.Username != username {
+			continue
+		}
+		if q := query.Get("query"); q != "" && !contains(p.Description, q) {
+			continue
+		}
+		filtered = append(filtered, p)
+	}
+	if len(filtered) == 0 {
+		notFound(w, "Photo")
+		return
+	}
+	count, _ := strconv.Atoi(query.Get("count"))
+	if count > maxPerPage {
+		writeErrors(w, http.StatusBadRequest, "count must be between 1 and 30")
+		return
+	}
+	// deterministic "random" sequence
+	pick := func() *Photo {
+		s.random = (s.random*7 + 3) % len(filtered)
+		return filtered[s.random]
+	}
+	if count == 0 {
+		writeJSON(w, http.StatusOK, s.renderPhoto(pick()))
+		return
+	}
+	var picked []*Photo
+	for i := 0; i < count; i++ {
+		picked = append(picked, pick())
+	}
+	writeJSON(w, http.StatusOK, s.renderPhotos(picked))
+}
+
+func (s *Server) serveCollections(w http.ResponseWriter, r *http.Request, parts []string) {
+	bearer := strings.HasPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if len(parts) == 1 || (len(parts) == 2 && parts[1] == "featured" && r.Method == http.MethodGet) {
+		switch r.Method {
+		case http.MethodGet:
+			var collections []*Collection
+			for i := range s.collections {
+				c := &s.collections[i]
+				if c.Private || (len(parts) == 2 && !c.Featured) {
+					continue
+				}
+				collections = append(collections, c)
+			}
+			s.pageCollections(w, r, collections)
+		case http.MethodPost:
+			query := r.URL.Query()
+			if query.Get("title") == "" {
+				writeErrors(w, http.StatusUnprocessableEntity, "Title can't be blank")
+				return
+			}
+			s.collections = append(s.collections, Collection{
+				ID:          s.nextCollectionID,
+				Username:    CurrentUser,
+				Title:       query.Get("title"),
+				Description: query.Get("description"),
+				Private:     query.Get("private") == "true",
+				PublishedAt: time.Now().UTC(),
+			})
+			s.nextCollectionID++
+			writeJSON(w, http.StatusCreated, s.renderCollection(&s.collections[len(s.collections)-1]))
+		default:
+			methodNotAllowed(w)
+		}
+		return
+	}
+	c := s.findCollection(parts[1])
+	if c == nil || !visible(c, bearer) {
+		notFound(w, "Collection")
+		return
+	}
+	if r.Method != http.MethodGet && c.Username != CurrentUser {
+		writeErrors(w, http.StatusForbidden, "Access denied")
+		return
+	}
+	switch {
+	case len(parts) == 2 && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, s.renderCollection(c))
+	case len(parts) == 2 && r.Method == http.MethodPut:
+		query := r.URL.Query()
+		if title := query.Get("title"); title != "" {
+			c.Title = title
+		}
+		if _, ok := query["description"]; ok {
+			c.Description = query.Get("description")
+		}
+		if private := query.Get("private"); private != "" {
+			c.Private = private == "true"
+		}
+		writeJSON(w, http.StatusOK, s.renderCollection(c))
+	case len(parts) == 2 && r.Method == http.MethodDelete:
+		for i := range s.collections {
+			if &s.collections[i] == c {
+				s.collections = append(s.collections[:i], s.collections[i+1:]...)
+				break
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+	case len(parts) == 3 && parts[2] == "photos" && r.Method == http.MethodGet:
+		photos, ok := filterOrientation(r, s.photosOf(c.PhotoIDs))
+		if !ok {
+			writeErrors(w, http.StatusBadRequest, "orientation is invalid")
+			return
+		}
+		s.pagePhotos(w, r, photos)
+	case len(parts) == 3 && parts[2] == "related" && r.Method == http.MethodGet:
+		var related []*Collection
+		for i := range s.collections {
+			if other := &s.collections[i]; other != c && !other.Private {
+				related = append(related, other)
+			}
+		}
+		writeJSON(w, http.StatusOK, s.renderCollections(related))
+	case len(parts) == 3 && (parts[2] == "add" && r.Method == http.MethodPost ||
+		parts[2] == "remove" && r.Method == http.MethodDelete):
+		p := s.findPhoto(r.URL.Query().Get("photo_id"))
+		if p == nil {
+			notFound(w, "Photo")
+			return
+		}
+		status := http.StatusCreated
+		ids := c.PhotoIDs[:0:0]
+		for _, id := range c.PhotoIDs {
+			if id != p.ID {
+				ids = append(ids, id)
+			}
+		}
+		if parts[2] == "add" {
+			ids = append([]string{p.ID}, ids...)
+		} else {
+			status = http.StatusOK
+		}
+		c.PhotoIDs = ids
+		writeJSON(w, status, object{
+			"photo":      s.renderPhoto(p),
+			"collection": s.renderCollection(c),
+			"user":       s.renderUser(s.findUser(CurrentUser)),
+			"created_at": time.Now().UTC().Format(time.RFC3339),
+		})
+	default:
+		notFound(w, "Collection")
+	}
+}
+
+func (s *Server) serveUsers(w http.ResponseWriter, r *http.Request, parts []string) {
+	if len(parts) < 2 || r.Method != http.MethodGet {
+		notFound(w, "User")
+		return
+	}
+	u := s.findUser(parts[1])
+	if u == nil {
+		notFound(w, "User")
+		return
+	}
+	if len(parts) == 2 {
+		writeJSON(w, http.StatusOK, s.renderUser(u))
+		return
+	}
+	switch parts[2] {
+	case "photos":
+		var photos []*Photo
+		for i := range s.photos {
+			if s.photos[i].Username == u.Username {
+				photos = append(photos, &s.photos[i])
+			}
+		}
+		photos, ok := filterOrientation(r, photos)
+		if !ok || !sortPhotos(r, photos) {
+			writeErrors(w, http.StatusBadRequest, "Invalid parameters")
+			return
+		}
+		s.pagePhotos(w, r, photos)
+	case "likes":
+		var photos []*Photo
+		for i := range s.photos {
+			if s.likes[u.Username][s.photos[i].ID] {
+				photos = append(photos, &s.photos[i])
+			}
+		}
+		s.pagePhotos(w, r, photos)
+	case "collections":
+		bearer := strings.HasPrefix(r.Header.Get("Authorization"), "Bearer ")
+		var collections []*Collection
+		for i := range s.collections {
+			c := &s.collections[i]
+			if c.Username == u.Username && visible(c, bearer) {
+				collections = append(collections, c)
+			}
+		}
+		s.pageCollections(w, r, collections)
+	case "statistics":
+		downloads, views, likes := 0, 0, 0
+		for _, p := range s.photos {
+			if p.Username == u.Username {
+				downloads += p.Downloads
+				views += p.Views
+				likes += p.Likes
+			}
+		}
+		writeJSON(w, http.StatusOK, object{
+			"username":  u.Username,
+			"downloads": object{"total": downloads, "historical": historical(r, downloads)},
+			"views":     object{"total": views, "historical": historical(r, views)},
+			"likes":     object{"total": likes, "historical": historical(r, likes)},
+		})
+	case "portfolio":
+		portfolio := u.PortfolioURL
+		if portfolio == "" {
+			portfolio = "https://example.com/" + url.PathEscape(u.Username)
+		}
+		writeJSON(w, http.StatusOK, object{"url": portfolio})
+	default:
+		notFound(w, "User")
+	}
+}
+
+func (s *Server) serveSearch(w http.ResponseWriter, r *http.Request, parts []string) {
+	query := r.URL.Query().Get("query")
+	if len(parts) != 2 || r.Method != http.MethodGet {
+		notFound(w, "Search")
+		return
+	}
+	if query == "" {
+		writeErrors(w, http.StatusBadRequest, "query is missing")
+		return
+	}
+	switch parts[1] {
+	case "photos":
+		var photos []*Photo
+		for i := range s.photos {
+			p := &s.photos[i]
+			match := contains(p.Description, query) || contains(p.AltDescription, query)
+			for _, tag := range p.Tags {
+				match = match || contains(tag, query)
+			}
+			if color := r.URL.Query().Get("color"); color != "" && !strings.EqualFold(color, p.Color) {
+				match = false
+			}
+			if match {
+				photos = append(photos, p)
+			}
+		}
+		photos, ok := filterOrientation(r, photos)
+		if !ok {
+			writeErrors(w, http.StatusBadRequest, "orientation is invalid")
+			return
+		}
+		if r.URL.Query().Get("order_by") == "latest" {
+			sortPhotos(r, photos)
+		}
+		s.searchResult(w, r, s.renderPhotos(photos))
+	case "users":
+		var users []object
+		for i := range s.users {
+			u := &s.users[i]
+			if contains(u.Username, query) || contains(u.Name(), query) {
+				users = append(users, s.renderUser(u))
+			}
+		}
+		s.searchResult(w, r, users)
+	case "collections":
+		var collections []*Collection
+		for i := range s.collections {
+			c := &s.collections[i]
+			if !c.Private && (contains(c.Title, query) || contains(c.Description, query)) {
+				collections = append(collections, c)
+			}
+		}
+		s.searchResult(w, r, s.renderCollections(collections))
+	default:
+		notFound(w, "Search")
+	}
+}
+
+// ImageData returns the bytes the server serves for the image of the
+// photo with id. They are deterministic, so downloads can be checked
+// against them.
+func ImageData(id string) []byte {
+	h := fnv.New32a()
+	h.Write([]byte(id))
+	state := h.Sum32()
+	data := make([]byte, imageSize)
+	for i := range data {
+		// xorshift keeps the bytes cheap to generate and incompressible
+		state ^= state << 13
+		state ^= state >> 17
+		state ^= state << 5
+		data[i] = byte(state)
+	}
+	return data
+}
+
+// serveImage serves the image bytes behind the photo URLs.
+// Range requests are supported so downloads can be resumed.
+func (s *Server) serveImage(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		methodNotAllowed(w)
+		return
+	}
+	id := strings.TrimPrefix(r.URL.Path, "/images/")
+	w.Header().Set("Content-Type", "image/jpeg")
+	w.Header().Set("ETag", fmt.Sprintf(`"%v"`, id))
+	http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(ImageData(id)))
+}
@@ -0,0 +1,309 @@
+// Copyright (c) 2017 Hardik Bagdi <hbagdi1@binghamton.edu>
+//
+// MIT License
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package unsplashtest
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// Mode selects what a Recorder does.
+type Mode int
+
+const (
+	// ModeReplay serves requests from the cassette only.
+	ModeReplay Mode = iota
+	// ModeRecord sends requests to the network and records them,
+	// overwriting the cassette.
+	ModeRecord
+	// ModeAuto replays the cassette if it exists and records it otherwise.
+	ModeAuto
+)
+
+// Redacted replaces secrets in recorded interactions.
+const Redacted = "REDACTED"
+
+// redactedHeaders are never written to a cassette.
+var redactedHeaders = []string{"Authorization", "Cookie", "Set-Cookie", "Proxy-Authorization"}
+
+// redactedParams are redacted from URLs, form bodies and JSON bodies.
+var redactedParams = []string{"client_id", "client_secret", "access_token", "refresh_token", "code"}
+
+var redactedJSON = regexp.MustCompile(`"(` + strings.Join(redactedParams, "|") + `)"(\s*):(\s*)"[^"]*"`)
+
+// Body of a recorded request or response.
+// Text is stored as is, anything else base64 encoded.
+type Body struct {
+	Text   string `json:"text,omitempty"`
+	Base64 string `json:"base64,omitempty"`
+}
+
+func newBody(b []byte) Body {
+	if utf8.Valid(b) {
+		return Body{Text: string(b)}
+	}
+	return Body{Base64: base64.StdEncoding.EncodeToString(b)}
+}
+
+// Bytes returns the body.
+func (b Body) Bytes() []byte {
+	if b.Base64 != "" {
+		data, _ := base64.StdEncoding.DecodeString(b.Base64)
+		return data
+	}
+	return []byte(b.Text)
+}
+
+// RecordedRequest is the request of an Interaction.
+type RecordedRequest struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header"`
+	Body   Body        `json:"body"`
+}
+
+// RecordedResponse is the response of an Interaction.
+type RecordedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header"`
+	Body       Body        `json:"body"`
+}
+
+// Interaction is a request and the response it got.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// Cassette is the file interactions are recorded to.
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Recorder is an http.RoundTripper recording interactions with the API to
+// a cassette and replaying them later, so tests run offline and
+// deterministically.
+// Credentials are redacted before anything is written to the cassette.
+type Recorder struct {
+	path      string
+	mode      Mode
+	transport http.RoundTripper
+
+	mu       sync.Mutex
+	cassette Cassette
+	used     []bool
+}
+
+// NewRecorder returns a Recorder for the cassette at path.
+// transport makes the requests while recording,
+// http.DefaultTransport is used if nil.
+// Recorded interactions are saved by Stop.
+func NewRecorder(path string, mode Mode, transport http.RoundTripper) (*Recorder, error) {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	if mode == ModeAuto {
+		mode = ModeRecord
+		if _, err := os.Stat(path); err == nil {
+			mode = ModeReplay
+		}
+	}
+	r := &Recorder{path: path, mode: mode, transport: transport}
+	if mode == ModeReplay {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if err = json.Unmarshal(data, &r.cassette); err != nil {
+			return nil, fmt.Errorf("unsplashtest: invalid cassette %v: %v", path, err)
+		}
+		r.used = make([]bool, len(r.cassette.Interactions))
+	}
+	return r, nil
+}
+
+// Mode returns the mode the recorder runs in.
+// ModeAuto is resolved to ModeRecord or ModeReplay.
+func (r *Recorder) Mode() Mode {
+	return r.mode
+}
+
+// Client returns an http.Client using the recorder,
+// to be passed to unsplash.New.
+func (r *Recorder) Client() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+	recorded := RecordedRequest{
+		Method: req.Method,
+		URL:    redactURL(req.URL),
+		Header: redactHeader(req.Header),
+		Body:   newBody(redactBody(body)),
+	}
+	if r.mode == ModeReplay {
+		return r.replay(req, recorded)
+	}
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(respBody))
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{
+		Request: recorded,
+		Response: RecordedResponse{
+			StatusCode: resp.StatusCode,
+			Header:     redactHeader(resp.Header),
+			Body:       newBody(redactBody(respBody)),
+		},
+	})
+	return resp, nil
+}
+
+// replay returns the first unused interaction matching the request.
+func (r *Recorder) replay(req *http.Request, recorded RecordedRequest) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, interaction := range r.cassette.Interactions {
+		if r.used[i] || interaction.Request.Method != recorded.Method ||
+			interaction.Request.URL != recorded.URL {
+			continue
+		}
+		r.used[i] = true
+		body := interaction.Response.Body.Bytes()
+		header := interaction.Response.Header.Clone()
+		if header == nil {
+			header = make(http.Header)
+		}
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %v", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+			StatusCode:    interaction.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          ioutil.NopCloser(bytes.NewReader(body)),
+			ContentLength: int64(len(body)),
+			Request:       req,
+		}, nil
+	}
+	return nil, fmt.Errorf("unsplashtest: no recorded interaction for %v %v", recorded.Method, recorded.URL)
+}
+
+// Stop saves the recorded interactions to the cassette.
+// It does nothing when replaying.
+func (r *Recorder) Stop() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+	r.mu.Lock()
+	data, err := json.MarshalIndent(r.cassette, "", "  ")
+	r.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(r.path), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(r.path, append(data, '\n'), 0644)
+}
+
+func redactHeader(header http.Header) http.Header {
+	redacted := header.Clone()
+	for _, key := range redactedHeaders {
+		values := redacted[http.CanonicalHeaderKey(key)]
+		for i, value := range values {
+			// keep the scheme, it tells client IDs and tokens apart
+			if fields := strings.Fields(value); len(fields) == 2 {
+				values[i] = fields[0] + " " + Redacted
+			} else {
+				values[i] = Redacted
+			}
+		}
+	}
+	return redacted
+}
+
+func redactURL(u *url.URL) string {
+	redacted := *u
+	query := redacted.Query()
+	for _, param := range redactedParams {
+		if _, ok := query[param]; ok {
+			query.Set(param, Redacted)
+		}
+	}
+	redacted.RawQuery = query.Encode()
+	return redacted.String()
+}
+
+func redactBody(body []byte) []byte {
+	if len(body) == 0 {
+		return body
+	}
+	body = redactedJSON.ReplaceAll(body, []byte(`"$1"$2:$3"`+Redacted+`"`))
+	// form encoded bodies, as sent to the token endpoint
+	if form, err := url.ParseQuery(string(body)); err == nil && bytes.ContainsRune(body, '=') &&
+		!bytes.ContainsAny(body, "{[ \n") {
+		changed := false
+		for _, param := range redactedParams {
+			if _, ok := form[param]; ok {
+				form.Set(param, Redacted)
+				changed = true
+			}
+		}
+		if changed {
+			body = []byte(form.Encode())
+		}
+	}
+	return body
+}
@@ -0,0 +1,126 @@
+// Copyright (c) 2017 Hardik Bagdi <hbagdi1@binghamton.edu>
+//
+// MIT License
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package unsplashtest_test
+
+import (
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hbagdi/go-unsplash/unsplash"
+	"github.com/hbagdi/go-unsplash/unsplash/unsplashtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecorder(T *testing.T) {
+	assert := assert.New(T)
+	log.SetOutput(ioutil.Discard)
+	dir, err := ioutil.TempDir("", "cassettes")
+	assert.Nil(err)
+	defer os.RemoveAll(dir)
+	cassette := filepath.Join(dir, "testdata", "photos.json")
+
+	server := unsplashtest.NewServer()
+	recorder, err := unsplashtest.NewRecorder(cassette, unsplashtest.ModeAuto, nil)
+	assert.Nil(err)
+	assert.Equal(unsplashtest.ModeRecord, recorder.Mode())
+	client := unsplash.NewWithClientID(recorder.Client(), "secret-key", unsplash.WithBaseURL(server.URL))
+	photo, _, err := client.Photos.Photo("photo-01", nil)
+	assert.Nil(err)
+	_, resp, err := client.Photos.All(nil)
+	assert.Nil(err)
+	_, err = client.Users.User("does-not-exist", nil)
+	assert.NotNil(err)
+	assert.Nil(recorder.Stop())
+	server.Close()
+
+	data, err := ioutil.ReadFile(cassette)
+	assert.Nil(err)
+	assert.Equal(false, strings.Contains(string(data), "secret-key"))
+	assert.Equal(true, strings.Contains(string(data), "Client-ID "+unsplashtest.Redacted))
+
+	// the server is gone, everything comes from the cassette
+	recorder, err = unsplashtest.NewRecorder(cassette, unsplashtest.ModeAuto, nil)
+	assert.Nil(err)
+	assert.Equal(unsplashtest.ModeReplay, recorder.Mode())
+	client = unsplash.NewWithClientID(recorder.Client(), "other-key", unsplash.WithBaseURL(server.URL))
+	replayed, _, err := client.Photos.Photo("photo-01", nil)
+	assert.Nil(err)
+	assert.Equal(*photo.ID, *replayed.ID)
+	assert.Equal(photo.Urls.Raw.String(), replayed.Urls.Raw.String())
+	_, replayedResp, err := client.Photos.All(nil)
+	assert.Nil(err)
+	assert.Equal(resp.NextPage, replayedResp.NextPage)
+	assert.Equal(resp.RateLimitRemaining, replayedResp.RateLimitRemaining)
+	_, err = client.Users.User("does-not-exist", nil)
+	_, ok := err.(*unsplash.NotFoundError)
+	assert.Equal(true, ok)
+
+	// each interaction is replayed once
+	_, _, err = client.Photos.Photo("photo-01", nil)
+	assert.NotNil(err)
+	assert.Nil(recorder.Stop())
+
+	_, err = unsplashtest.NewRecorder(filepath.Join(dir, "missing.json"), unsplashtest.ModeReplay, nil)
+	assert.NotNil(err)
+}
+
+func TestRecorderRedactsTokens(T *testing.T) {
+	assert := assert.New(T)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token": "secret-token", "refresh_token":"secret-refresh", "scope": "public"}`))
+	}))
+	defer server.Close()
+	dir, err := ioutil.TempDir("", "cassettes")
+	assert.Nil(err)
+	defer os.RemoveAll(dir)
+	cassette := filepath.Join(dir, "token.json")
+
+	recorder, err := unsplashtest.NewRecorder(cassette, unsplashtest.ModeRecord, nil)
+	assert.Nil(err)
+	form := url.Values{"client_secret": {"secret-client"}, "code": {"secret-code"}, "grant_type": {"authorization_code"}}
+	req, _ := http.NewRequest("POST", server.URL+"/oauth/token?client_id=secret-id", strings.NewReader(form.Encode()))
+	req.Header.Set("Authorization", "Bearer secret-bearer")
+	resp, err := recorder.Client().Do(req)
+	assert.Nil(err)
+	body, _ := ioutil.ReadAll(resp.Body)
+	// the caller still gets the real response
+	assert.Equal(true, strings.Contains(string(body), "secret-token"))
+	assert.Nil(recorder.Stop())
+
+	data, err := ioutil.ReadFile(cassette)
+	assert.Nil(err)
+	for _, secret := range []string{"secret-token", "secret-refresh", "secret-client", "secret-code", "secret-id", "secret-bearer"} {
+		assert.Equal(false, strings.Contains(string(data), secret), secret)
+	}
+	assert.Equal(true, strings.Contains(string(data), "authorization_code"))
+	assert.Equal(true, strings.Contains(string(data), "public"))
+}
@@ -0,0 +1,258 @@
+// Copyright (c) 2017 Hardik Bagdi <hbagdi1@binghamton.edu>
+//
+// MIT License
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+// Package unsplashtest provides an in-process fake of the Unsplash API
+// for tests.
+//
+// The fake server implements the photos, collections, users, search, stats
+// and /me endpoints on top of seeded fixture data. Lists are paginated with
+// Link headers, every response carries rate limit headers and faults can be
+// injected to exercise error handling.
+//
+//	server := unsplashtest.NewServer()
+//	defer server.Close()
+//	client := unsplash.NewWithClientID(nil, "key", unsplash.WithBaseURL(server.URL))
+//
+// Read endpoints accept any Client-ID or Bearer Authorization header.
+// /me and write endpoints need a Bearer token, which is authenticated as
+// CurrentUser.
+//
+// Recorder records interactions with the real API to a cassette file and
+// replays them later, with credentials redacted.
+package unsplashtest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// DefaultRateLimit is the hourly rate limit of a new server.
+const DefaultRateLimit = 5000
+
+// Fault makes the server fail the requests it matches.
+type Fault struct {
+	// Method to match, any method if empty.
+	Method string
+	// Path to match, like /photos/photo-01. A trailing * matches any
+	// path with that prefix. Any path if empty.
+	Path string
+	// Status of the response.
+	Status int
+	// Errors returned in the body, the status text if empty.
+	Errors []string
+	// Times is the number of requests to fail, all of them if 0.
+	Times int
+}
+
+func (f *Fault) matches(r *http.Request) bool {
+	if f.Method != "" && f.Method != r.Method {
+		return false
+	}
+	if strings.HasSuffix(f.Path, "*") {
+		return strings.HasPrefix(r.URL.Path, strings.TrimSuffix(f.Path, "*"))
+	}
+	return f.Path == "" || f.Path == r.URL.Path
+}
+
+// Request is a request received by the server.
+type Request struct {
+	Method string
+	Path   string
+	Query  url.Values
+	Header http.Header
+}
+
+// Server is a fake Unsplash API server.
+type Server struct {
+	*httptest.Server
+
+	mu               sync.Mutex
+	users            []User
+	photos           []Photo
+	collections      []Collection
+	likes            map[string]map[string]bool
+	nextCollectionID int
+	rateLimit        int
+	remaining        int
+	faults           []*Fault
+	requests         []Request
+	random           int
+}
+
+// NewServer starts a fake server seeded with fixture data.
+// The caller should call Close when finished, to shut it down.
+func NewServer() *Server {
+	s := &Server{
+		likes:     make(map[string]map[string]bool),
+		rateLimit: DefaultRateLimit,
+		remaining: DefaultRateLimit,
+	}
+	s.seed()
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// SetRateLimit sets the rate limit of the server and resets the
+// number of remaining requests to limit.
+func (s *Server) SetRateLimit(limit int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rateLimit = limit
+	s.remaining = limit
+}
+
+// Inject makes the server fail requests matching f.
+// Faults are checked in the order they were injected.
+func (s *Server) Inject(f Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = append(s.faults, &f)
+}
+
+// ClearFaults removes all the injected faults.
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = nil
+}
+
+// Requests returns the API requests received so far.
+// Image requests are not recorded.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+// AddUser adds a user to the fixtures.
+func (s *Server) AddUser(u User) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.users = append(s.users, u)
+}
+
+// AddPhoto adds a photo to the fixtures.
+func (s *Server) AddPhoto(p Photo) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.photos = append(s.photos, p)
+}
+
+// AddCollection adds a collection to the fixtures and returns its ID.
+// If c.ID is 0, a new ID is assigned.
+func (s *Server) AddCollection(c Collection) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if c.ID == 0 {
+		c.ID = s.nextCollectionID
+		s.nextCollectionID++
+	}
+	s.collections = append(s.collections, c)
+	return c.ID
+}
+
+// Photo returns the photo with id.
+func (s *Server) Photo(id string) (Photo, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if p := s.findPhoto(id); p != nil {
+		return *p, true
+	}
+	return Photo{}, false
+}
+
+// Collection returns the collection with id.
+func (s *Server) Collection(id int) (Collection, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if c := s.findCollection(strconv.Itoa(id)); c != nil {
+		return *c, true
+	}
+	return Collection{}, false
+}
+
+// User returns the user with username.
+func (s *Server) User(username string) (User, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if u := s.findUser(username); u != nil {
+		return *u, true
+	}
+	return User{}, false
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if strings.HasPrefix(r.URL.Path, "/images/") {
+		s.serveImage(w, r)
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests = append(s.requests, Request{
+		Method: r.Method,
+		Path:   r.URL.Path,
+		Query:  r.URL.Query(),
+		Header: r.Header.Clone(),
+	})
+	if s.remaining > 0 {
+		s.remaining--
+	} else {
+		s.setRateLimitHeaders(w)
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte("Rate Limit Exceeded"))
+		return
+	}
+	s.setRateLimitHeaders(w)
+	for i, f := range s.faults {
+		if !f.matches(r) {
+			continue
+		}
+		if f.Times > 0 {
+			f.Times--
+			if f.Times == 0 {
+				s.faults = append(s.faults[:i], s.faults[i+1:]...)
+			}
+		}
+		errs := f.Errors
+		if len(errs) == 0 {
+			errs = []string{http.StatusText(f.Status)}
+		}
+		writeErrors(w, f.Status, errs...)
+		return
+	}
+	auth := r.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, "Client-ID ") && !strings.HasPrefix(auth, "Bearer ") {
+		writeErrors(w, http.StatusUnauthorized, "OAuth error: The access token is invalid")
+		return
+	}
+	s.route(w, r, strings.HasPrefix(auth, "Bearer "))
+}
+
+func (s *Server) setRateLimitHeaders(w http.ResponseWriter) {
+	w.Header().Set("X-Ratelimit-Limit", strconv.Itoa(s.rateLimit))
+	w.Header().Set("X-Ratelimit-Remaining", strconv.Itoa(s.remaining))
+}
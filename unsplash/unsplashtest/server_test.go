@@ -0,0 +1,244 @@
+// Copyright (c) 2017 Hardik Bagdi <hbagdi1@binghamton.edu>
+//
+// MIT License
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package unsplashtest_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hbagdi/go-unsplash/unsplash"
+	"github.com/hbagdi/go-unsplash/unsplash/unsplashtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func clients(server *unsplashtest.Server) (*unsplash.Unsplash, *unsplash.Unsplash) {
+	public := unsplash.NewWithClientID(nil, "key", unsplash.WithBaseURL(server.URL))
+	user := unsplash.New(nil, unsplash.WithBaseURL(server.URL),
+		unsplash.WithHeader("Authorization", "Bearer token"))
+	return public, user
+}
+
+func TestServerPagination(T *testing.T) {
+	assert := assert.New(T)
+	log.SetOutput(ioutil.Discard)
+	server := unsplashtest.NewServer()
+	defer server.Close()
+	client, _ := clients(server)
+
+	photos, resp, err := client.Photos.All(&unsplash.ListOpt{OrderBy: unsplash.Latest})
+	assert.Nil(err)
+	assert.Equal(10, len(*photos))
+	assert.Equal(true, resp.HasNextPage)
+	assert.Equal(2, resp.NextPage)
+	assert.Equal(4, resp.LastPage)
+	assert.Equal(unsplashtest.DefaultRateLimit, resp.RateLimit)
+	assert.Equal(unsplashtest.DefaultRateLimit-1, resp.RateLimitRemaining)
+	// latest first
+	assert.Equal("photo-40", *(*photos)[0].ID)
+
+	photos, resp, err = client.Photos.All(&unsplash.ListOpt{Page: 4, PerPage: 10, OrderBy: unsplash.Latest})
+	assert.Nil(err)
+	assert.Equal(false, resp.HasNextPage)
+	assert.Equal(3, resp.PrevPage)
+	assert.Equal("photo-01", *(*photos)[9].ID)
+
+	all, err := client.Photos.AllPager(context.Background(), nil, nil).FetchAll(0)
+	assert.Nil(err)
+	assert.Equal(unsplashtest.SeededPhotos, len(all))
+
+	collections, _, err := client.Collections.All(nil)
+	assert.Nil(err)
+	for _, c := range *collections {
+		assert.Equal(false, *c.Private)
+	}
+	featured, _, err := client.Collections.Featured(nil)
+	assert.Nil(err)
+	assert.Equal(true, len(*featured) < len(*collections))
+}
+
+func TestServerResources(T *testing.T) {
+	assert := assert.New(T)
+	log.SetOutput(ioutil.Discard)
+	server := unsplashtest.NewServer()
+	defer server.Close()
+	client, _ := clients(server)
+
+	photo, _, err := client.Photos.Photo("photo-01", nil)
+	assert.Nil(err)
+	assert.Equal(unsplashtest.CurrentUser, *photo.Photographer.Username)
+	assert.Equal(true, strings.HasPrefix(photo.Urls.Raw.String(), server.URL+"/images/photo-01"))
+
+	_, _, err = client.Photos.Photo("missing", nil)
+	_, ok := err.(*unsplash.NotFoundError)
+	assert.Equal(true, ok)
+
+	random, _, err := client.Photos.Random(&unsplash.RandomPhotoOpt{Count: 3, Orientation: unsplash.Portrait})
+	assert.Nil(err)
+	assert.Equal(3, len(*random))
+	for _, p := range *random {
+		assert.Equal(true, *p.Height > *p.Width)
+	}
+
+	stats, _, err := client.Photos.Statistics("photo-01", nil)
+	assert.Nil(err)
+	assert.Equal(30, stats.Downloads.Historical.Quantity)
+
+	user, err := client.Users.User("ferris", nil)
+	assert.Nil(err)
+	assert.Equal("ferris", *user.Username)
+
+	userPhotos, _, err := client.Users.Photos("ferris", nil)
+	assert.Nil(err)
+	for _, p := range *userPhotos {
+		assert.Equal("ferris", *p.Photographer.Username)
+	}
+
+	collection, _, err := client.Collections.Collection("1")
+	assert.Nil(err)
+	assert.Equal(5, *collection.TotalPhotos)
+	collectionPhotos, _, err := client.Collections.Photos("1", nil)
+	assert.Nil(err)
+	assert.Equal(5, len(*collectionPhotos))
+
+	total, _, err := client.TotalStats()
+	assert.Nil(err)
+	assert.Equal(uint64(unsplashtest.SeededPhotos), total.Photos)
+	_, _, err = client.MonthStats()
+	assert.Nil(err)
+
+	results, _, err := client.Search.Photos(&unsplash.SearchOpt{Query: "MOUNTAIN"})
+	assert.Nil(err)
+	assert.Equal(true, *results.Total > 0)
+	for _, p := range *results.Results {
+		assert.Equal(true, strings.Contains(strings.ToLower(*p.Description), "mountain"))
+	}
+	users, _, err := client.Search.Users(&unsplash.SearchOpt{Query: "gopher"})
+	assert.Nil(err)
+	assert.Equal(1, *users.Total)
+
+	var image bytes.Buffer
+	_, err = client.Photos.Download(photo, &image)
+	assert.Nil(err)
+	assert.Equal(unsplashtest.ImageData("photo-01"), image.Bytes())
+
+	before, _ := server.Photo("photo-01")
+	_, _, err = client.Photos.TrackDownload(photo)
+	assert.Nil(err)
+	after, _ := server.Photo("photo-01")
+	assert.Equal(before.Downloads+1, after.Downloads)
+}
+
+func TestServerWrites(T *testing.T) {
+	assert := assert.New(T)
+	log.SetOutput(ioutil.Discard)
+	server := unsplashtest.NewServer()
+	defer server.Close()
+	public, client := clients(server)
+
+	_, _, err := public.CurrentUser()
+	_, ok := err.(*unsplash.AuthorizationError)
+	assert.Equal(true, ok)
+
+	me, _, err := client.CurrentUser()
+	assert.Nil(err)
+	assert.Equal(unsplashtest.CurrentUser, *me.Username)
+	me, _, err = client.UpdateCurrentUser(&unsplash.UserUpdateInfo{Bio: "Gophers all the way down"})
+	assert.Nil(err)
+	assert.Equal("Gophers all the way down", *me.Bio)
+
+	before, _ := server.Photo("photo-02")
+	_, _, err = client.Photos.Like("photo-02")
+	assert.Nil(err)
+	after, _ := server.Photo("photo-02")
+	assert.Equal(before.Likes+1, after.Likes)
+	liked, _, err := client.Users.LikedPhotos(unsplashtest.CurrentUser, nil)
+	assert.Nil(err)
+	assert.Equal(1, len(*liked))
+	_, _, err = client.Photos.Unlike("photo-02")
+	assert.Nil(err)
+
+	title := "Gophers"
+	collection, _, err := client.Collections.Create(&unsplash.CollectionOpt{Title: &title})
+	assert.Nil(err)
+	id := *collection.ID
+	_, err = client.Collections.AddPhoto(id, "photo-03")
+	assert.Nil(err)
+	c, ok := server.Collection(id)
+	assert.Equal(true, ok)
+	assert.Equal([]string{"photo-03"}, c.PhotoIDs)
+	_, err = client.Collections.RemovePhoto(id, "photo-03")
+	assert.Nil(err)
+	_, err = client.Collections.Delete(id)
+	assert.Nil(err)
+	_, ok = server.Collection(id)
+	assert.Equal(false, ok)
+
+	// collection 2 belongs to someone else
+	_, err = client.Collections.Delete(2)
+	_, ok = err.(*unsplash.AuthorizationError)
+	assert.Equal(true, ok)
+
+	requests := server.Requests()
+	last := requests[len(requests)-1]
+	assert.Equal(http.MethodDelete, last.Method)
+	assert.Equal("/collections/2", last.Path)
+	assert.Equal("Bearer token", last.Header.Get("Authorization"))
+}
+
+func TestServerFaults(T *testing.T) {
+	assert := assert.New(T)
+	log.SetOutput(ioutil.Discard)
+	server := unsplashtest.NewServer()
+	defer server.Close()
+	client, _ := clients(server)
+
+	server.Inject(unsplashtest.Fault{Path: "/photos/*", Status: http.StatusInternalServerError, Times: 1})
+	_, _, err := client.Photos.Photo("photo-01", nil)
+	assert.NotNil(err)
+	_, ok := err.(*unsplash.ErrorResponse)
+	assert.Equal(true, ok)
+	_, _, err = client.Photos.Photo("photo-01", nil)
+	assert.Nil(err)
+
+	server.Inject(unsplashtest.Fault{Method: http.MethodGet, Path: "/users/gopher", Status: http.StatusNotFound})
+	_, err = client.Users.User("gopher", nil)
+	_, ok = err.(*unsplash.NotFoundError)
+	assert.Equal(true, ok)
+	server.ClearFaults()
+	_, err = client.Users.User("gopher", nil)
+	assert.Nil(err)
+
+	server.SetRateLimit(1)
+	_, resp, err := client.Photos.All(nil)
+	assert.Nil(err)
+	assert.Equal(0, resp.RateLimitRemaining)
+	_, _, err = client.Photos.All(nil)
+	_, ok = err.(*unsplash.RateLimitError)
+	assert.Equal(true, ok)
+}
@@ -52,6 +52,14 @@ type UserBadge struct {
 	Link    *URL    `json:"link,omitempty"`
 }
 
+// UserSocial contains the social profiles of a user
+type UserSocial struct {
+	InstagramUsername *string `json:"instagram_username"`
+	PortfolioURL      *URL    `json:"portfolio_url"`
+	TwitterUsername   *string `json:"twitter_username"`
+	PaypalEmail       *string `json:"paypal_email"`
+}
+
 // User represents a Unsplash.com user
 type User struct {
 	UID                 *string       `json:"uid"`
@@ -67,6 +75,10 @@ type User struct {
 	TotalLikes          *int          `json:"total_likes"`
 	TotalPhotos         *int          `json:"total_photos"`
 	TotalCollections    *int          `json:"total_collections"`
+	TotalPromotedPhotos *int          `json:"total_promoted_photos"`
+	TotalIllustrations  *int          `json:"total_illustrations"`
+	ForHire             *bool         `json:"for_hire"`
+	AcceptedTOS         *bool         `json:"accepted_tos"`
 	FollowedByUser      *bool         `json:"followed_by_user"`
 	NumericID           *int          `json:"numeric_id"`
 	FollowersCount      *int          `json:"followers_count"`
@@ -79,6 +91,7 @@ type User struct {
 	UpdatedAt           *string       `json:"updated_at"`
 	InstagramUsername   *string       `json:"instagram_username"`
 	TwitterUsername     *string       `json:"twitter_username"`
+	Social              *UserSocial   `json:"social"`
 }
 
 func (u *User) String() string {
@@ -24,6 +24,7 @@
 package unsplash
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -39,11 +40,16 @@ type UsersService service
 
 // User returns a User with username and optional profile image size ImageOpt
 func (us *UsersService) User(username string, imageOpt *ProfileImageOpt) (*User, error) {
+	return us.UserWithContext(context.Background(), username, imageOpt)
+}
+
+// UserWithContext is like User but uses ctx for the request.
+func (us *UsersService) UserWithContext(ctx context.Context, username string, imageOpt *ProfileImageOpt) (*User, error) {
 	if "" == username {
 		return nil, &IllegalArgumentError{ErrString: "Username cannot be null"}
 	}
 	endpoint := fmt.Sprintf("%v/%v", getEndpoint(users), username)
-	req, err := newRequest(GET, endpoint, imageOpt, nil)
+	req, err := us.client.newRequest(ctx, GET, endpoint, imageOpt, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -65,11 +71,16 @@ type urlWrapper struct {
 
 // Portfolio returns a User with username and optional profile image size ImageOpt
 func (us *UsersService) Portfolio(username string) (*URL, error) {
+	return us.PortfolioWithContext(context.Background(), username)
+}
+
+// PortfolioWithContext is like Portfolio but uses ctx for the request.
+func (us *UsersService) PortfolioWithContext(ctx context.Context, username string) (*URL, error) {
 	if "" == username {
 		return nil, &IllegalArgumentError{ErrString: "Username cannot be null"}
 	}
 	endpoint := fmt.Sprintf("%v/%v/portfolio", getEndpoint(users), username)
-	req, err := newRequest(GET, endpoint, nil, nil)
+	req, err := us.client.newRequest(ctx, GET, endpoint, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -87,36 +98,173 @@ func (us *UsersService) Portfolio(username string) (*URL, error) {
 
 // Photos return an array of photos uploaded by the user.
 func (us *UsersService) Photos(username string, opt *ListOpt) (*[]Photo, *Response, error) {
+	return us.PhotosWithContext(context.Background(), username, opt)
+}
+
+// PhotosWithContext is like Photos but uses ctx for the request.
+func (us *UsersService) PhotosWithContext(ctx context.Context, username string, opt *ListOpt) (*[]Photo, *Response, error) {
 	if "" == username {
 		return nil, nil, &IllegalArgumentError{ErrString: "Username cannot be null"}
 	}
 	s := (service)(*us)
 	endpoint := fmt.Sprintf("%v/%v/%v", getEndpoint(users), username, getEndpoint(photos))
-	return s.getPhotos(opt, endpoint)
+	return s.getPhotos(ctx, opt, endpoint)
+}
+
+// PhotosPager returns a PhotoPager over photos uploaded by the user.
+func (us *UsersService) PhotosPager(ctx context.Context, username string, opt *ListOpt, pagerOpt *PagerOpt) *PhotoPager {
+	if "" == username {
+		return &PhotoPager{pager: pager{err: &IllegalArgumentError{ErrString: "Username cannot be null"}}}
+	}
+	s := (service)(*us)
+	endpoint := fmt.Sprintf("%v/%v/%v", getEndpoint(users), username, getEndpoint(photos))
+	return s.photoPager(ctx, opt, endpoint, pagerOpt)
 }
 
 // LikedPhotos return an array of liked photos
 func (us *UsersService) LikedPhotos(username string, opt *ListOpt) (*[]Photo, *Response, error) {
+	return us.LikedPhotosWithContext(context.Background(), username, opt)
+}
+
+// LikedPhotosWithContext is like LikedPhotos but uses ctx for the request.
+func (us *UsersService) LikedPhotosWithContext(ctx context.Context, username string, opt *ListOpt) (*[]Photo, *Response, error) {
 	if "" == username {
 		return nil, nil, &IllegalArgumentError{ErrString: "Username cannot be null"}
 	}
 	s := (service)(*us)
 	endpoint := fmt.Sprintf("%v/%v/%v", getEndpoint(users), username, "likes")
-	return s.getPhotos(opt, endpoint)
+	return s.getPhotos(ctx, opt, endpoint)
+}
+
+// LikedPhotosPager returns a PhotoPager over photos liked by the user.
+func (us *UsersService) LikedPhotosPager(ctx context.Context, username string, opt *ListOpt, pagerOpt *PagerOpt) *PhotoPager {
+	if "" == username {
+		return &PhotoPager{pager: pager{err: &IllegalArgumentError{ErrString: "Username cannot be null"}}}
+	}
+	s := (service)(*us)
+	endpoint := fmt.Sprintf("%v/%v/%v", getEndpoint(users), username, "likes")
+	return s.photoPager(ctx, opt, endpoint, pagerOpt)
 }
 
 // Collections return an array of user's collections.
 func (us *UsersService) Collections(username string, opt *ListOpt) (*[]Collection, *Response, error) {
+	return us.CollectionsWithContext(context.Background(), username, opt)
+}
+
+// CollectionsWithContext is like Collections but uses ctx for the request.
+func (us *UsersService) CollectionsWithContext(ctx context.Context, username string, opt *ListOpt) (*[]Collection, *Response, error) {
 	if "" == username {
 		return nil, nil, &IllegalArgumentError{ErrString: "Username cannot be null"}
 	}
 	s := (service)(*us)
 	endpoint := fmt.Sprintf("%v/%v/%v", getEndpoint(users), username, getEndpoint(collections))
-	return s.getCollections(opt, endpoint)
+	return s.getCollections(ctx, opt, endpoint)
+}
+
+// CollectionsPager returns a CollectionPager over the user's collections.
+func (us *UsersService) CollectionsPager(ctx context.Context, username string, opt *ListOpt, pagerOpt *PagerOpt) *CollectionPager {
+	if "" == username {
+		return &CollectionPager{pager: pager{err: &IllegalArgumentError{ErrString: "Username cannot be null"}}}
+	}
+	s := (service)(*us)
+	endpoint := fmt.Sprintf("%v/%v/%v", getEndpoint(users), username, getEndpoint(collections))
+	return s.collectionPager(ctx, opt, endpoint, pagerOpt)
+}
+
+// Followers returns a list of users following the user.
+func (us *UsersService) Followers(username string, opt *ListOpt) (*[]User, *Response, error) {
+	return us.FollowersWithContext(context.Background(), username, opt)
+}
+
+// FollowersWithContext is like Followers but uses ctx for the request.
+func (us *UsersService) FollowersWithContext(ctx context.Context, username string, opt *ListOpt) (*[]User, *Response, error) {
+	if "" == username {
+		return nil, nil, &IllegalArgumentError{ErrString: "Username cannot be null"}
+	}
+	s := (service)(*us)
+	endpoint := fmt.Sprintf("%v/%v/followers", getEndpoint(users), username)
+	return s.getUsers(ctx, opt, endpoint)
+}
+
+// FollowersPager returns a UserPager over users following the user.
+func (us *UsersService) FollowersPager(ctx context.Context, username string, opt *ListOpt, pagerOpt *PagerOpt) *UserPager {
+	if "" == username {
+		return &UserPager{pager: pager{err: &IllegalArgumentError{ErrString: "Username cannot be null"}}}
+	}
+	s := (service)(*us)
+	endpoint := fmt.Sprintf("%v/%v/followers", getEndpoint(users), username)
+	return s.userPager(ctx, opt, endpoint, pagerOpt)
+}
+
+// Following returns a list of users the user is following.
+func (us *UsersService) Following(username string, opt *ListOpt) (*[]User, *Response, error) {
+	return us.FollowingWithContext(context.Background(), username, opt)
+}
+
+// FollowingWithContext is like Following but uses ctx for the request.
+func (us *UsersService) FollowingWithContext(ctx context.Context, username string, opt *ListOpt) (*[]User, *Response, error) {
+	if "" == username {
+		return nil, nil, &IllegalArgumentError{ErrString: "Username cannot be null"}
+	}
+	s := (service)(*us)
+	endpoint := fmt.Sprintf("%v/%v/following", getEndpoint(users), username)
+	return s.getUsers(ctx, opt, endpoint)
+}
+
+// FollowingPager returns a UserPager over users the user is following.
+func (us *UsersService) FollowingPager(ctx context.Context, username string, opt *ListOpt, pagerOpt *PagerOpt) *UserPager {
+	if "" == username {
+		return &UserPager{pager: pager{err: &IllegalArgumentError{ErrString: "Username cannot be null"}}}
+	}
+	s := (service)(*us)
+	endpoint := fmt.Sprintf("%v/%v/following", getEndpoint(users), username)
+	return s.userPager(ctx, opt, endpoint, pagerOpt)
+}
+
+// Follow follows the user on the currently authenticated user's behalf.
+// This requires the write_followers scope.
+func (us *UsersService) Follow(username string) (*Response, error) {
+	return us.FollowWithContext(context.Background(), username)
+}
+
+// FollowWithContext is like Follow but uses ctx for the request.
+func (us *UsersService) FollowWithContext(ctx context.Context, username string) (*Response, error) {
+	return us.follow(ctx, POST, username)
+}
+
+// Unfollow unfollows the user on the currently authenticated user's behalf.
+// This requires the write_followers scope.
+func (us *UsersService) Unfollow(username string) (*Response, error) {
+	return us.UnfollowWithContext(context.Background(), username)
+}
+
+// UnfollowWithContext is like Unfollow but uses ctx for the request.
+func (us *UsersService) UnfollowWithContext(ctx context.Context, username string) (*Response, error) {
+	return us.follow(ctx, DELETE, username)
+}
+
+func (us *UsersService) follow(ctx context.Context, m method, username string) (*Response, error) {
+	if err := us.client.requireScope(WriteFollowers); err != nil {
+		return nil, err
+	}
+	if "" == username {
+		return nil, &IllegalArgumentError{ErrString: "Username cannot be null"}
+	}
+	endpoint := fmt.Sprintf("%v/%v/follow", getEndpoint(users), username)
+	req, err := us.client.newRequest(ctx, m, endpoint, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return us.client.do(req)
 }
 
 // Statistics return a stats about a photo with id.
 func (us *UsersService) Statistics(username string, opt *StatsOpt) (*UserStatistics, *Response, error) {
+	return us.StatisticsWithContext(context.Background(), username, opt)
+}
+
+// StatisticsWithContext is like Statistics but uses ctx for the request.
+func (us *UsersService) StatisticsWithContext(ctx context.Context, username string, opt *StatsOpt) (*UserStatistics, *Response, error) {
 	if "" == username {
 		return nil, nil, &IllegalArgumentError{ErrString: "Photo ID cannot be null"}
 	}
@@ -127,7 +275,7 @@ func (us *UsersService) Statistics(username string, opt *StatsOpt) (*UserStatist
 		return nil, nil, &InvalidStatsOptError{ErrString: "opt provided is not valid."}
 	}
 	endpoint := fmt.Sprintf("%v/%v/statistics", getEndpoint(users), username)
-	req, err := newRequest(GET, endpoint, opt, nil)
+	req, err := us.client.newRequest(ctx, GET, endpoint, opt, nil)
 	if err != nil {
 		return nil, nil, err
 	}
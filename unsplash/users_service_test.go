@@ -24,8 +24,12 @@
 package unsplash
 
 import (
+	"context"
+	"fmt"
 	"io/ioutil"
 	"log"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/jarcoal/httpmock"
@@ -237,3 +241,75 @@ func TestUserServiceRogueStuff(T *testing.T) {
 	rogueUserServiceTests(T, httpmock.NewStringResponder(200, `Bad ass Bug flow`))
 	rogueUserServiceTests(T, nil)
 }
+
+func TestFollowers(T *testing.T) {
+	assert := assert.New(T)
+	log.SetOutput(ioutil.Discard)
+	var requests []string
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		switch r.URL.Path {
+		case "/users/gopher/followers", "/users/gopher/following":
+			page := r.URL.Query().Get("page")
+			if page == "1" {
+				w.Header().Set("Link", fmt.Sprintf(`<%v%v?page=2>; rel="next", <%v%v?page=2>; rel="last"`,
+					server.URL, r.URL.Path, server.URL, r.URL.Path))
+			}
+			fmt.Fprintf(w, `[{"username": "follower%v-1"}, {"username": "follower%v-2"}]`, page, page)
+		case "/users/gopher/follow":
+			w.WriteHeader(204)
+		default:
+			w.WriteHeader(404)
+		}
+	}))
+	defer server.Close()
+	unsplash := New(nil, WithBaseURL(server.URL))
+
+	users, resp, err := unsplash.Users.Followers("gopher", nil)
+	assert.Nil(err)
+	assert.Equal(true, resp.HasNextPage)
+	assert.Equal(2, len(*users))
+	assert.Equal("follower1-1", *(*users)[0].Username)
+
+	users, _, err = unsplash.Users.Following("gopher", &ListOpt{Page: 2})
+	assert.Nil(err)
+	assert.Equal("follower2-2", *(*users)[1].Username)
+
+	var usernames []string
+	pager := unsplash.Users.FollowingPager(context.Background(), "gopher", nil, nil)
+	for pager.Next() {
+		usernames = append(usernames, *pager.User().Username)
+	}
+	assert.Nil(pager.Err())
+	assert.Equal([]string{"follower1-1", "follower1-2", "follower2-1", "follower2-2"}, usernames)
+
+	all, err := unsplash.Users.FollowersPager(context.Background(), "gopher", nil, nil).FetchAll(2)
+	assert.Nil(err)
+	assert.Equal(4, len(all))
+
+	requests = nil
+	resp, err = unsplash.Users.Follow("gopher")
+	assert.Nil(err)
+	assert.NotNil(resp)
+	resp, err = unsplash.Users.Unfollow("gopher")
+	assert.Nil(err)
+	assert.NotNil(resp)
+	assert.Equal([]string{"POST /users/gopher/follow", "DELETE /users/gopher/follow"}, requests)
+
+	for _, err = range []error{
+		func() error { _, _, err := unsplash.Users.Followers("", nil); return err }(),
+		func() error { _, _, err := unsplash.Users.Following("", nil); return err }(),
+		func() error { _, err := unsplash.Users.Follow(""); return err }(),
+		func() error { _, err := unsplash.Users.Unfollow(""); return err }(),
+		unsplash.Users.FollowersPager(context.Background(), "", nil, nil).Err(),
+	} {
+		_, ok := err.(*IllegalArgumentError)
+		assert.Equal(true, ok)
+	}
+
+	_, err = unsplash.Users.Follow("nobody")
+	assert.NotNil(err)
+	_, ok := err.(*NotFoundError)
+	assert.Equal(true, ok)
+}